@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 
 	"github.com/sourcegraph/createsend-go/createsend"
 )
@@ -14,6 +17,8 @@ var verbose = flag.Bool("v", false, "verbose")
 
 var apiclient *createsend.APIClient
 
+var ctx = context.Background()
+
 func main() {
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: createsend command [OPTS] ARGS...\n")
@@ -27,6 +32,7 @@ func main() {
 		fmt.Fprintln(os.Stderr, "\tget-subscriber   LIST EMAIL")
 		fmt.Fprintln(os.Stderr, "\tadd-subscriber   LIST EMAIL")
 		fmt.Fprintln(os.Stderr, "\tunsubscribe      LIST EMAIL")
+		fmt.Fprintln(os.Stderr, "\ttemplates sync   -client CLIENT -url URL [-prune] [-n] [-state FILE] DIR")
 		fmt.Fprintln(os.Stderr)
 		fmt.Fprintln(os.Stderr)
 		fmt.Fprintln(os.Stderr, "Common arguments:")
@@ -77,11 +83,13 @@ func main() {
 		addSubscriber(remaining)
 	case "unsubscribe":
 		unsubscribe(remaining)
+	case "templates":
+		templates(remaining)
 	}
 }
 
 func listClients(args []string) {
-	clients, err := apiclient.ListClients()
+	clients, err := apiclient.ListClients(ctx)
 	if err != nil {
 		log.Fatalf("Error listing clients: %s\n", err)
 	}
@@ -101,7 +109,7 @@ func listLists(args []string) {
 	}
 
 	clientID := args[0]
-	lists, err := apiclient.ListLists(clientID)
+	lists, err := apiclient.ListLists(ctx, clientID)
 	if err != nil {
 		log.Fatalf("Error listing lists: %s\n", err)
 	}
@@ -121,7 +129,7 @@ func listsForEmail(args []string) {
 	}
 
 	clientID, email := args[0], args[1]
-	lists, err := apiclient.ListsForEmail(clientID, email)
+	lists, err := apiclient.ListsForEmail(ctx, clientID, email)
 	if err != nil {
 		log.Fatalf("Error listing lists for email address %q: %s\n", email, err)
 	}
@@ -141,7 +149,7 @@ func listSubscribers(args []string) {
 	}
 
 	listID, group := args[0], createsend.SubscriberGroup(args[1])
-	subs, err := apiclient.ListSubscribers(listID, group, nil)
+	subs, err := apiclient.ListSubscribers(ctx, listID, group, nil)
 	if err != nil {
 		log.Fatalf("Error listing subcribers for list %q: %s\n", listID, err)
 	}
@@ -161,7 +169,7 @@ func getSubscriber(args []string) {
 	}
 
 	listID, email := args[0], args[1]
-	sub, err := apiclient.GetSubscriber(listID, email)
+	sub, err := apiclient.GetSubscriber(ctx, listID, email)
 	if err != nil {
 		log.Fatalf("Error getting subcriber %q for list %q: %s\n", email, listID, err)
 	}
@@ -175,7 +183,7 @@ func addSubscriber(args []string) {
 	}
 
 	listID, email := args[0], args[1]
-	err := apiclient.AddSubscriber(listID, createsend.NewSubscriber{EmailAddress: email})
+	err := apiclient.AddSubscriber(ctx, listID, createsend.NewSubscriber{EmailAddress: email})
 	if err != nil {
 		log.Fatalf("Error adding subcriber %q to list %q: %s\n", email, listID, err)
 	}
@@ -189,9 +197,102 @@ func unsubscribe(args []string) {
 	}
 
 	listID, email := args[0], args[1]
-	err := apiclient.Unsubscribe(listID, email)
+	err := apiclient.Unsubscribe(ctx, listID, email)
 	if err != nil {
 		log.Fatalf("Error unsubscribing %q from list %q: %s\n", email, listID, err)
 	}
 	fmt.Printf("Unsubscribed %q from list %q.\n", email, listID)
 }
+
+func templates(args []string) {
+	if len(args) == 0 || args[0] != "sync" {
+		log.Println("templates takes a subcommand (sync).")
+		flag.Usage()
+	}
+	templatesSync(args[1:])
+}
+
+func templatesSync(args []string) {
+	fs := flag.NewFlagSet("templates sync", flag.ExitOnError)
+	clientID := fs.String("client", "", "client ID")
+	baseURL := fs.String("url", "", "URL at which DIR is published")
+	prune := fs.Bool("prune", false, "delete the client's templates that are not in DIR")
+	dryRun := fs.Bool("n", false, "show the plan without applying it")
+	stateFile := fs.String("state", "", "file recording the content synced to each template (default: createsend/templates-CLIENT.json in the user cache directory)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: createsend templates sync -client CLIENT -url URL [-prune] [-n] [-state FILE] DIR")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Makes a client's templates match the NAME.html (and optional NAME.zip)")
+		fmt.Fprintln(os.Stderr, "files in DIR, which must be published at URL. Existing templates are")
+		fmt.Fprintln(os.Stderr, "only updated if their files changed since the last sync recorded in the")
+		fmt.Fprintln(os.Stderr, "state file; without one, all of them are re-uploaded.")
+		fmt.Fprintln(os.Stderr)
+		fs.PrintDefaults()
+		os.Exit(1)
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 || *clientID == "" || *baseURL == "" {
+		fs.Usage()
+	}
+	dir := fs.Arg(0)
+	if *stateFile == "" {
+		// Keep the state out of DIR, which is published.
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			log.Fatalf("Error locating state file (use -state): %s\n", err)
+		}
+		*stateFile = filepath.Join(cacheDir, "createsend", "templates-"+*clientID+".json")
+	}
+
+	base, err := url.Parse(*baseURL)
+	if err != nil {
+		log.Fatalf("Error parsing URL %q: %s\n", *baseURL, err)
+	}
+	if base.Path == "" || base.Path[len(base.Path)-1] != '/' {
+		base.Path += "/"
+	}
+
+	local, err := createsend.LocalTemplates(dir, base)
+	if err != nil {
+		log.Fatalf("Error reading templates in %s: %s\n", dir, err)
+	}
+	remote, err := apiclient.ClientTemplates(ctx, *clientID)
+	if err != nil {
+		log.Fatalf("Error listing templates for client %q: %s\n", *clientID, err)
+	}
+
+	synced, err := createsend.ReadSyncedTemplates(*stateFile)
+	if err != nil {
+		log.Fatalf("Error reading %s: %s\n", *stateFile, err)
+	}
+	if synced == nil && len(remote) > 0 {
+		fmt.Printf("No state in %s: existing templates will be re-uploaded.\n", *stateFile)
+	}
+
+	plan := createsend.PlanTemplateSync(local, remote, synced, *prune)
+	if plan.Empty() {
+		fmt.Println("No changes.")
+		return
+	}
+	fmt.Print(plan)
+	if *dryRun {
+		return
+	}
+
+	if err := apiclient.ApplyTemplatePlan(ctx, *clientID, plan); err != nil {
+		log.Fatalf("Error syncing templates: %s\n", err)
+	}
+	fmt.Printf("Synced %d templates.\n", len(plan.Create)+len(plan.Update)+len(plan.Delete))
+
+	remote, err = apiclient.ClientTemplates(ctx, *clientID)
+	if err != nil {
+		log.Fatalf("Error listing templates for client %q: %s\n", *clientID, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(*stateFile), 0755); err != nil {
+		log.Fatalf("Error writing %s: %s\n", *stateFile, err)
+	}
+	if err := createsend.WriteSyncedTemplates(*stateFile, createsend.SyncedTemplates(local, remote)); err != nil {
+		log.Fatalf("Error writing %s: %s\n", *stateFile, err)
+	}
+}
@@ -0,0 +1,179 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActivitySyncer_Sync(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/opens.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		q := r.URL.Query()
+		if q.Get("orderfield") != "date" || q.Get("orderdirection") != "asc" {
+			t.Errorf("Querystring = %s, want ascending date order", r.URL.RawQuery)
+		}
+		switch q.Get("date") {
+		case "":
+			switch q.Get("page") {
+			case "1":
+				fmt.Fprint(w, `{"Results":[{"EmailAddress":"a@example.com","Date":"2010-10-11 08:29:00"},{"EmailAddress":"b@example.com","Date":"2010-10-11 09:15:30"}],"PageNumber":1,"NumberOfPages":2}`)
+			case "2":
+				fmt.Fprint(w, `{"Results":[{"EmailAddress":"c@example.com","Date":"2010-10-12 10:00:00"}],"PageNumber":2,"NumberOfPages":2}`)
+			}
+		case "2010-10-12 10:00":
+			fmt.Fprint(w, `{"Results":[{"EmailAddress":"c@example.com","Date":"2010-10-12 10:00:00"},{"EmailAddress":"d@example.com","Date":"2010-10-12 10:00:59"}],"PageNumber":1,"NumberOfPages":1}`)
+		default:
+			t.Errorf("Unexpected date filter %q", q.Get("date"))
+		}
+	})
+
+	store := &MemoryCheckpointStore{}
+	s := &ActivitySyncer{Client: client, Store: store}
+
+	var emails []string
+	collect := func(activity []Activity) error {
+		for _, a := range activity {
+			if a.Type != OpenActivity || a.Open == nil {
+				t.Errorf("Sync returned %+v, want an open", a)
+				continue
+			}
+			emails = append(emails, a.Open.EmailAddress)
+		}
+		return nil
+	}
+
+	if err := s.Sync(context.Background(), "13CD", OpenActivity, collect); err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+	want := []string{"a@example.com", "b@example.com", "c@example.com"}
+	if !reflect.DeepEqual(emails, want) {
+		t.Errorf("Sync returned %v, want %v", emails, want)
+	}
+	wm, _ := store.Load("13CD/opens")
+	if want := time.Date(2010, 10, 12, 10, 0, 0, 0, time.UTC); !wm.Equal(want) {
+		t.Errorf("watermark = %v, want %v", wm, want)
+	}
+
+	// The second sync only returns activity at or after the watermark.
+	emails = nil
+	if err := s.Sync(context.Background(), "13CD", OpenActivity, collect); err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+	if want := []string{"c@example.com", "d@example.com"}; !reflect.DeepEqual(emails, want) {
+		t.Errorf("Sync returned %v, want %v", emails, want)
+	}
+}
+
+func TestActivitySyncer_Sync_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/bounces.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Results":[{"EmailAddress":"a@example.com","BounceType":"Hard","Date":"2010-10-11 08:29:00"}],"PageNumber":1,"NumberOfPages":1}`)
+	})
+
+	store := &MemoryCheckpointStore{}
+	s := &ActivitySyncer{Client: client, Store: store}
+
+	fail := errors.New("fail")
+	err := s.Sync(context.Background(), "13CD", BounceActivity, func([]Activity) error { return fail })
+	if err != fail {
+		t.Errorf("Sync returned error %v, want %v", err, fail)
+	}
+	if wm, _ := store.Load("13CD/bounces"); !wm.IsZero() {
+		t.Errorf("watermark = %v, want zero after failed processing", wm)
+	}
+}
+
+func TestActivitySyncer_Sync_errorAtPageBoundary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/opens.json", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("date") + "/" + q.Get("page") {
+		case "/1":
+			fmt.Fprint(w, `{"Results":[{"EmailAddress":"a@example.com","Date":"2010-10-11 10:00:05"}],"PageNumber":1,"NumberOfPages":2}`)
+		case "/2":
+			fmt.Fprint(w, `{"Results":[{"EmailAddress":"b@example.com","Date":"2010-10-11 10:00:05"}],"PageNumber":2,"NumberOfPages":2}`)
+		case "2010-10-11 10:00/1":
+			fmt.Fprint(w, `{"Results":[{"EmailAddress":"a@example.com","Date":"2010-10-11 10:00:05"},{"EmailAddress":"b@example.com","Date":"2010-10-11 10:00:05"}],"PageNumber":1,"NumberOfPages":1}`)
+		default:
+			t.Errorf("Unexpected querystring %s", r.URL.RawQuery)
+		}
+	})
+
+	s := &ActivitySyncer{Client: client, Store: &MemoryCheckpointStore{}, PageSize: 1}
+
+	// Processing fails on the second page, after the first page's watermark
+	// (the same second) has been saved.
+	fail := errors.New("fail")
+	err := s.Sync(context.Background(), "13CD", OpenActivity, func(activity []Activity) error {
+		if activity[0].Open.EmailAddress == "b@example.com" {
+			return fail
+		}
+		return nil
+	})
+	if err != fail {
+		t.Errorf("Sync returned error %v, want %v", err, fail)
+	}
+
+	// The next sync must still deliver b.
+	var emails []string
+	err = s.Sync(context.Background(), "13CD", OpenActivity, func(activity []Activity) error {
+		for _, a := range activity {
+			emails = append(emails, a.Open.EmailAddress)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+	if want := []string{"a@example.com", "b@example.com"}; !reflect.DeepEqual(emails, want) {
+		t.Errorf("Sync returned %v, want %v", emails, want)
+	}
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "createsend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "checkpoints.json")
+	s := &FileCheckpointStore{Path: path}
+
+	if wm, err := s.Load("13CD/opens"); err != nil || !wm.IsZero() {
+		t.Errorf("Load on missing file = %v, %v; want zero time, nil", wm, err)
+	}
+
+	t1 := time.Date(2010, 10, 11, 8, 29, 0, 0, time.UTC)
+	t2 := time.Date(2010, 10, 12, 9, 0, 0, 0, time.UTC)
+	if err := s.Save("13CD/opens", t1); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	if err := s.Save("13CD/clicks", t2); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	// A new store reading the same file sees both watermarks.
+	s = &FileCheckpointStore{Path: path}
+	if wm, _ := s.Load("13CD/opens"); !wm.Equal(t1) {
+		t.Errorf("Load(opens) = %v, want %v", wm, t1)
+	}
+	if wm, _ := s.Load("13CD/clicks"); !wm.Equal(t2) {
+		t.Errorf("Load(clicks) = %v, want %v", wm, t2)
+	}
+}
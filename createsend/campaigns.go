@@ -1,9 +1,11 @@
 package createsend
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // CampaignRecipientsOptions represents the URL parameters that may be used to
@@ -37,7 +39,7 @@ type Recipient struct {
 	ListID       string `json:"ListID"`
 }
 
-func (c *APIClient) CampaignRecipients(campaignID string, opt *CampaignRecipientsOptions) (*CampaignRecipients, error) {
+func (c *APIClient) CampaignRecipients(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) (*CampaignRecipients, error) {
 
 	u := fmt.Sprintf("campaigns/%s/recipients.json", campaignID)
 
@@ -68,6 +70,542 @@ func (c *APIClient) CampaignRecipients(campaignID string, opt *CampaignRecipient
 	}
 
 	var results CampaignRecipients
-	err = c.Do(req, &results)
+	err = c.Do(ctx, req, &results)
 	return &results, err
 }
+
+// CampaignCreate represents the parameters needed to create a campaign from
+// HTML and text content hosted at HtmlUrl and TextUrl.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#creating_a_campaign for
+// more information.
+type CampaignCreate struct {
+	Name       string   `json:"Name"`
+	Subject    string   `json:"Subject"`
+	FromName   string   `json:"FromName"`
+	FromEmail  string   `json:"FromEmail"`
+	ReplyTo    string   `json:"ReplyTo"`
+	HtmlUrl    string   `json:"HtmlUrl"`
+	TextUrl    string   `json:"TextUrl,omitempty"`
+	ListIDs    []string `json:"ListIDs,omitempty"`
+	SegmentIDs []string `json:"SegmentIDs,omitempty"`
+}
+
+// CreateCampaign creates a draft campaign for a client and returns its ID.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#creating_a_campaign for
+// more information.
+func (c *APIClient) CreateCampaign(ctx context.Context, clientID string, campaign *CampaignCreate) (string, error) {
+	u := fmt.Sprintf("campaigns/%s.json", clientID)
+
+	req, err := c.NewRequest("POST", u, campaign)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = c.Do(ctx, req, &id)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// CampaignFromTemplateCreate represents the parameters needed to create a
+// campaign from a template.
+//
+// See
+// https://www.campaignmonitor.com/api/campaigns/#creating_a_campaign_from_template
+// for more information.
+type CampaignFromTemplateCreate struct {
+	Name            string          `json:"Name"`
+	Subject         string          `json:"Subject"`
+	FromName        string          `json:"FromName"`
+	FromEmail       string          `json:"FromEmail"`
+	ReplyTo         string          `json:"ReplyTo"`
+	ListIDs         []string        `json:"ListIDs,omitempty"`
+	SegmentIDs      []string        `json:"SegmentIDs,omitempty"`
+	TemplateID      string          `json:"TemplateID"`
+	TemplateContent TemplateContent `json:"TemplateContent"`
+}
+
+// TemplateContent represents the content used to fill in the editable regions
+// of a template.
+type TemplateContent struct {
+	Singlelines []TemplateSingleline `json:"Singlelines,omitempty"`
+	Multilines  []TemplateMultiline  `json:"Multilines,omitempty"`
+	Images      []TemplateImage      `json:"Images,omitempty"`
+	Repeaters   []TemplateRepeater   `json:"Repeaters,omitempty"`
+}
+
+type TemplateSingleline struct {
+	Content string `json:"Content"`
+	Href    string `json:"Href,omitempty"`
+}
+
+type TemplateMultiline struct {
+	Content string `json:"Content"`
+}
+
+type TemplateImage struct {
+	Content string `json:"Content"`
+	Alt     string `json:"Alt,omitempty"`
+	Href    string `json:"Href,omitempty"`
+}
+
+type TemplateRepeater struct {
+	Items []TemplateRepeaterItem `json:"Items"`
+}
+
+type TemplateRepeaterItem struct {
+	Layout      string               `json:"Layout"`
+	Singlelines []TemplateSingleline `json:"Singlelines,omitempty"`
+	Multilines  []TemplateMultiline  `json:"Multilines,omitempty"`
+	Images      []TemplateImage      `json:"Images,omitempty"`
+}
+
+// CreateCampaignFromTemplate creates a draft campaign for a client from one of
+// its templates and returns the campaign's ID.
+//
+// See
+// https://www.campaignmonitor.com/api/campaigns/#creating_a_campaign_from_template
+// for more information.
+func (c *APIClient) CreateCampaignFromTemplate(ctx context.Context, clientID string, campaign *CampaignFromTemplateCreate) (string, error) {
+	u := fmt.Sprintf("campaigns/%s/fromtemplate.json", clientID)
+
+	req, err := c.NewRequest("POST", u, campaign)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = c.Do(ctx, req, &id)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// SendImmediately is the CampaignSend.SendDate value that sends a campaign
+// straight away.
+const SendImmediately = "Immediately"
+
+// CampaignSend represents the parameters needed to send or schedule a draft
+// campaign. SendDate is either SendImmediately or a date in the client's time
+// zone formatted as "2006-01-02 15:04".
+//
+// See https://www.campaignmonitor.com/api/campaigns/#sending_a_campaign for
+// more information.
+type CampaignSend struct {
+	ConfirmationEmail string `json:"ConfirmationEmail"`
+	SendDate          string `json:"SendDate"`
+}
+
+// SendCampaign sends or schedules a draft campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#sending_a_campaign for
+// more information.
+func (c *APIClient) SendCampaign(ctx context.Context, campaignID string, send *CampaignSend) error {
+	u := fmt.Sprintf("campaigns/%s/send.json", campaignID)
+
+	req, err := c.NewRequest("POST", u, send)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// CampaignPreview represents the parameters needed to send a preview of a
+// draft campaign. Personalize is "Fallback", "Random" or the email address of
+// a subscriber whose custom fields are used.
+//
+// See
+// https://www.campaignmonitor.com/api/campaigns/#sending_a_campaign_preview
+// for more information.
+type CampaignPreview struct {
+	PreviewRecipients []string `json:"PreviewRecipients"`
+	Personalize       string   `json:"Personalize,omitempty"`
+}
+
+// SendCampaignPreview sends a preview of a draft campaign.
+//
+// See
+// https://www.campaignmonitor.com/api/campaigns/#sending_a_campaign_preview
+// for more information.
+func (c *APIClient) SendCampaignPreview(ctx context.Context, campaignID string, preview *CampaignPreview) error {
+	u := fmt.Sprintf("campaigns/%s/sendpreview.json", campaignID)
+
+	req, err := c.NewRequest("POST", u, preview)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// UnscheduleCampaign cancels the sending of a scheduled campaign, which
+// becomes a draft again.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#unscheduling_a_campaign
+// for more information.
+func (c *APIClient) UnscheduleCampaign(ctx context.Context, campaignID string) error {
+	u := fmt.Sprintf("campaigns/%s/unschedule.json", campaignID)
+
+	req, err := c.NewRequest("POST", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// DeleteCampaign deletes a draft or scheduled campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#deleting_a_campaign for
+// more information.
+func (c *APIClient) DeleteCampaign(ctx context.Context, campaignID string) error {
+	u := fmt.Sprintf("campaigns/%s.json", campaignID)
+
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// CampaignSummary represents the basic reporting statistics of a sent
+// campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_summary for
+// more information.
+type CampaignSummary struct {
+	Recipients        int    `json:"Recipients"`
+	TotalOpened       int    `json:"TotalOpened"`
+	UniqueOpened      int    `json:"UniqueOpened"`
+	Clicks            int    `json:"Clicks"`
+	Unsubscribed      int    `json:"Unsubscribed"`
+	Bounced           int    `json:"Bounced"`
+	SpamComplaints    int    `json:"SpamComplaints"`
+	Forwards          int    `json:"Forwards"`
+	Likes             int    `json:"Likes"`
+	Mentions          int    `json:"Mentions"`
+	WebVersionURL     string `json:"WebVersionURL"`
+	WebVersionTextURL string `json:"WebVersionTextURL"`
+	WorldviewURL      string `json:"WorldviewURL"`
+}
+
+// CampaignSummary returns the basic reporting statistics of a sent campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_summary for
+// more information.
+func (c *APIClient) CampaignSummary(ctx context.Context, campaignID string) (*CampaignSummary, error) {
+	u := fmt.Sprintf("campaigns/%s/summary.json", campaignID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary CampaignSummary
+	err = c.Do(ctx, req, &summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// EmailClientUsage represents the share of a campaign's recipients that
+// opened it with a given email client.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_email_client_usage
+// for more information.
+type EmailClientUsage struct {
+	Client      string  `json:"Client"`
+	Version     string  `json:"Version"`
+	Percentage  float64 `json:"Percentage"`
+	Subscribers int     `json:"Subscribers"`
+}
+
+// CampaignEmailClientUsage returns the email clients that were used to open a
+// campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_email_client_usage
+// for more information.
+func (c *APIClient) CampaignEmailClientUsage(ctx context.Context, campaignID string) ([]*EmailClientUsage, error) {
+	u := fmt.Sprintf("campaigns/%s/emailclientusage.json", campaignID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage []*EmailClientUsage
+	err = c.Do(ctx, req, &usage)
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// CampaignListsAndSegments represents the lists and segments a campaign was
+// sent to.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_listsandsegments
+// for more information.
+type CampaignListsAndSegments struct {
+	Lists    []*List       `json:"Lists"`
+	Segments []ListSegment `json:"Segments"`
+}
+
+// CampaignListsAndSegments returns the lists and segments a campaign was sent
+// to.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_listsandsegments
+// for more information.
+func (c *APIClient) CampaignListsAndSegments(ctx context.Context, campaignID string) (*CampaignListsAndSegments, error) {
+	u := fmt.Sprintf("campaigns/%s/listsandsegments.json", campaignID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ls CampaignListsAndSegments
+	err = c.Do(ctx, req, &ls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ls, nil
+}
+
+// CampaignActivityOptions represents the URL parameters that may be used to
+// filter and page through a campaign's opens, clicks, bounces, unsubscribes
+// and spam complaints. If Date is set, only activity on or after it (in the
+// client's time zone, to the minute) is returned.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_opens for more
+// information.
+type CampaignActivityOptions struct {
+	Date           time.Time
+	Page           int
+	PageSize       int
+	OrderField     string
+	OrderDirection string
+}
+
+// CampaignOpen represents a recipient opening a campaign.
+type CampaignOpen struct {
+	EmailAddress string  `json:"EmailAddress"`
+	ListID       string  `json:"ListID"`
+	Date         string  `json:"Date"`
+	IPAddress    string  `json:"IPAddress"`
+	Latitude     float64 `json:"Latitude"`
+	Longitude    float64 `json:"Longitude"`
+	City         string  `json:"City"`
+	Region       string  `json:"Region"`
+	CountryCode  string  `json:"CountryCode"`
+	CountryName  string  `json:"CountryName"`
+}
+
+type CampaignOpensResponse struct {
+	Results              []*CampaignOpen `json:"Results"`
+	ResultsOrderedBy     string          `json:"ResultsOrderedBy"`
+	OrderDirection       string          `json:"OrderDirection"`
+	PageNumber           int             `json:"PageNumber"`
+	PageSize             int             `json:"PageSize"`
+	RecordsOnThisPage    int             `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int             `json:"TotalNumberOfRecords"`
+	NumberOfPages        int             `json:"NumberOfPages"`
+}
+
+// CampaignOpens lists the opens of a campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_opens for more
+// information.
+func (c *APIClient) CampaignOpens(ctx context.Context, campaignID string, opt *CampaignActivityOptions) (*CampaignOpensResponse, error) {
+	var results CampaignOpensResponse
+	err := c.campaignActivity(ctx, campaignID, "opens", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// CampaignClick represents a recipient clicking a link in a campaign.
+type CampaignClick struct {
+	EmailAddress string  `json:"EmailAddress"`
+	URL          string  `json:"URL"`
+	ListID       string  `json:"ListID"`
+	Date         string  `json:"Date"`
+	IPAddress    string  `json:"IPAddress"`
+	Latitude     float64 `json:"Latitude"`
+	Longitude    float64 `json:"Longitude"`
+	City         string  `json:"City"`
+	Region       string  `json:"Region"`
+	CountryCode  string  `json:"CountryCode"`
+	CountryName  string  `json:"CountryName"`
+}
+
+type CampaignClicksResponse struct {
+	Results              []*CampaignClick `json:"Results"`
+	ResultsOrderedBy     string           `json:"ResultsOrderedBy"`
+	OrderDirection       string           `json:"OrderDirection"`
+	PageNumber           int              `json:"PageNumber"`
+	PageSize             int              `json:"PageSize"`
+	RecordsOnThisPage    int              `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int              `json:"TotalNumberOfRecords"`
+	NumberOfPages        int              `json:"NumberOfPages"`
+}
+
+// CampaignClicks lists the link clicks of a campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_clicks for more
+// information.
+func (c *APIClient) CampaignClicks(ctx context.Context, campaignID string, opt *CampaignActivityOptions) (*CampaignClicksResponse, error) {
+	var results CampaignClicksResponse
+	err := c.campaignActivity(ctx, campaignID, "clicks", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// CampaignBounce represents a campaign email that bounced.
+type CampaignBounce struct {
+	EmailAddress string `json:"EmailAddress"`
+	ListID       string `json:"ListID"`
+	BounceType   string `json:"BounceType"`
+	Date         string `json:"Date"`
+	Reason       string `json:"Reason"`
+}
+
+type CampaignBouncesResponse struct {
+	Results              []*CampaignBounce `json:"Results"`
+	ResultsOrderedBy     string            `json:"ResultsOrderedBy"`
+	OrderDirection       string            `json:"OrderDirection"`
+	PageNumber           int               `json:"PageNumber"`
+	PageSize             int               `json:"PageSize"`
+	RecordsOnThisPage    int               `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int               `json:"TotalNumberOfRecords"`
+	NumberOfPages        int               `json:"NumberOfPages"`
+}
+
+// CampaignBounces lists the bounces of a campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_bounces for
+// more information.
+func (c *APIClient) CampaignBounces(ctx context.Context, campaignID string, opt *CampaignActivityOptions) (*CampaignBouncesResponse, error) {
+	var results CampaignBouncesResponse
+	err := c.campaignActivity(ctx, campaignID, "bounces", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// CampaignUnsubscribe represents a recipient unsubscribing from a campaign.
+type CampaignUnsubscribe struct {
+	EmailAddress string `json:"EmailAddress"`
+	ListID       string `json:"ListID"`
+	Date         string `json:"Date"`
+	IPAddress    string `json:"IPAddress"`
+}
+
+type CampaignUnsubscribesResponse struct {
+	Results              []*CampaignUnsubscribe `json:"Results"`
+	ResultsOrderedBy     string                 `json:"ResultsOrderedBy"`
+	OrderDirection       string                 `json:"OrderDirection"`
+	PageNumber           int                    `json:"PageNumber"`
+	PageSize             int                    `json:"PageSize"`
+	RecordsOnThisPage    int                    `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int                    `json:"TotalNumberOfRecords"`
+	NumberOfPages        int                    `json:"NumberOfPages"`
+}
+
+// CampaignUnsubscribes lists the unsubscribes caused by a campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_unsubscribes
+// for more information.
+func (c *APIClient) CampaignUnsubscribes(ctx context.Context, campaignID string, opt *CampaignActivityOptions) (*CampaignUnsubscribesResponse, error) {
+	var results CampaignUnsubscribesResponse
+	err := c.campaignActivity(ctx, campaignID, "unsubscribes", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// CampaignSpamComplaint represents a recipient marking a campaign as spam.
+type CampaignSpamComplaint struct {
+	EmailAddress string `json:"EmailAddress"`
+	ListID       string `json:"ListID"`
+	Date         string `json:"Date"`
+}
+
+type CampaignSpamComplaintsResponse struct {
+	Results              []*CampaignSpamComplaint `json:"Results"`
+	ResultsOrderedBy     string                   `json:"ResultsOrderedBy"`
+	OrderDirection       string                   `json:"OrderDirection"`
+	PageNumber           int                      `json:"PageNumber"`
+	PageSize             int                      `json:"PageSize"`
+	RecordsOnThisPage    int                      `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int                      `json:"TotalNumberOfRecords"`
+	NumberOfPages        int                      `json:"NumberOfPages"`
+}
+
+// CampaignSpamComplaints lists the spam complaints about a campaign.
+//
+// See https://www.campaignmonitor.com/api/campaigns/#campaign_spam_complaints
+// for more information.
+func (c *APIClient) CampaignSpamComplaints(ctx context.Context, campaignID string, opt *CampaignActivityOptions) (*CampaignSpamComplaintsResponse, error) {
+	var results CampaignSpamComplaintsResponse
+	err := c.campaignActivity(ctx, campaignID, "spam", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// campaignActivity fetches a page of the given kind of campaign activity
+// ("opens", "clicks", etc.) into results.
+func (c *APIClient) campaignActivity(ctx context.Context, campaignID string, kind string, opt *CampaignActivityOptions, results interface{}) error {
+	u := fmt.Sprintf("campaigns/%s/%s.json", campaignID, kind)
+
+	if opt != nil {
+		v := url.Values{}
+		if !opt.Date.IsZero() {
+			v.Set("date", opt.Date.Format("2006-01-02 15:04"))
+		}
+		if opt.Page > 0 {
+			v.Set("page", strconv.Itoa(opt.Page))
+		}
+		if opt.PageSize > 0 {
+			v.Set("pagesize", strconv.Itoa(opt.PageSize))
+		}
+		if opt.OrderField != "" {
+			v.Set("orderfield", opt.OrderField)
+		}
+		if opt.OrderDirection != "" {
+			v.Set("orderdirection", opt.OrderDirection)
+		}
+
+		q := v.Encode()
+		if q != "" {
+			u = fmt.Sprintf("%s?%s", u, q)
+		}
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, results)
+}
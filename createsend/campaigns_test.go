@@ -1,10 +1,12 @@
 package createsend
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestCampaignRecipients(t *testing.T) {
@@ -42,7 +44,7 @@ func TestCampaignRecipients(t *testing.T) {
 		}`)
 	})
 
-	campaigns, err := client.CampaignRecipients("13CD", nil)
+	campaigns, err := client.CampaignRecipients(context.Background(), "13CD", nil)
 	if err != nil {
 		t.Errorf("CampaignRecipients returned error: %v", err)
 	}
@@ -134,7 +136,7 @@ func TestCampaignRecipientsOptions(t *testing.T) {
 		OrderField:     "email",
 		OrderDirection: "desc",
 	}
-	campaigns, err := client.CampaignRecipients("13CD", &opts)
+	campaigns, err := client.CampaignRecipients(context.Background(), "13CD", &opts)
 	if err != nil {
 		t.Errorf("CampaignRecipients returned error: %v", err)
 	}
@@ -172,3 +174,378 @@ func TestCampaignRecipientsOptions(t *testing.T) {
 		t.Errorf("CampaignRecipients returend %+v, want %+v", campaigns, want)
 	}
 }
+
+func TestCreateCampaign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"Name":"March","Subject":"News","FromName":"Me","FromEmail":"me@example.com","ReplyTo":"me@example.com","HtmlUrl":"http://example.com/campaign.html","ListIDs":["34cd"],"SegmentIDs":["56ef"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `"13CD"`)
+	})
+
+	id, err := client.CreateCampaign(context.Background(), "12ab", &CampaignCreate{
+		Name:       "March",
+		Subject:    "News",
+		FromName:   "Me",
+		FromEmail:  "me@example.com",
+		ReplyTo:    "me@example.com",
+		HtmlUrl:    "http://example.com/campaign.html",
+		ListIDs:    []string{"34cd"},
+		SegmentIDs: []string{"56ef"},
+	})
+	if err != nil {
+		t.Errorf("CreateCampaign returned error: %v", err)
+	}
+	if id != "13CD" {
+		t.Errorf("CreateCampaign returned %q, want %q", id, "13CD")
+	}
+}
+
+func TestCreateCampaignFromTemplate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/12ab/fromtemplate.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"Name":"March","Subject":"News","FromName":"Me","FromEmail":"me@example.com","ReplyTo":"me@example.com","ListIDs":["34cd"],"TemplateID":"78gh",`+
+			`"TemplateContent":{"Singlelines":[{"Content":"Title","Href":"http://example.com/"}],"Multilines":[{"Content":"Body"}],"Images":[{"Content":"http://example.com/a.jpg","Alt":"A"}],`+
+			`"Repeaters":[{"Items":[{"Layout":"My layout","Singlelines":[{"Content":"Item"}]}]}]}}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `"13CD"`)
+	})
+
+	id, err := client.CreateCampaignFromTemplate(context.Background(), "12ab", &CampaignFromTemplateCreate{
+		Name:       "March",
+		Subject:    "News",
+		FromName:   "Me",
+		FromEmail:  "me@example.com",
+		ReplyTo:    "me@example.com",
+		ListIDs:    []string{"34cd"},
+		TemplateID: "78gh",
+		TemplateContent: TemplateContent{
+			Singlelines: []TemplateSingleline{{Content: "Title", Href: "http://example.com/"}},
+			Multilines:  []TemplateMultiline{{Content: "Body"}},
+			Images:      []TemplateImage{{Content: "http://example.com/a.jpg", Alt: "A"}},
+			Repeaters: []TemplateRepeater{{Items: []TemplateRepeaterItem{
+				{Layout: "My layout", Singlelines: []TemplateSingleline{{Content: "Item"}}},
+			}}},
+		},
+	})
+	if err != nil {
+		t.Errorf("CreateCampaignFromTemplate returned error: %v", err)
+	}
+	if id != "13CD" {
+		t.Errorf("CreateCampaignFromTemplate returned %q, want %q", id, "13CD")
+	}
+}
+
+func TestSendCampaign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/send.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ConfirmationEmail":"me@example.com","SendDate":"Immediately"}`+"\n")
+	})
+
+	err := client.SendCampaign(context.Background(), "13CD", &CampaignSend{ConfirmationEmail: "me@example.com", SendDate: SendImmediately})
+	if err != nil {
+		t.Errorf("SendCampaign returned error: %v", err)
+	}
+}
+
+func TestSendCampaignPreview(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/sendpreview.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"PreviewRecipients":["a@example.com"],"Personalize":"Random"}`+"\n")
+	})
+
+	err := client.SendCampaignPreview(context.Background(), "13CD", &CampaignPreview{PreviewRecipients: []string{"a@example.com"}, Personalize: "Random"})
+	if err != nil {
+		t.Errorf("SendCampaignPreview returned error: %v", err)
+	}
+}
+
+func TestUnscheduleCampaign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/unschedule.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	err := client.UnscheduleCampaign(context.Background(), "13CD")
+	if err != nil {
+		t.Errorf("UnscheduleCampaign returned error: %v", err)
+	}
+}
+
+func TestDeleteCampaign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	err := client.DeleteCampaign(context.Background(), "13CD")
+	if err != nil {
+		t.Errorf("DeleteCampaign returned error: %v", err)
+	}
+}
+
+func TestCampaignSummary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/summary.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"Recipients": 5,
+			"TotalOpened": 10,
+			"Clicks": 0,
+			"Unsubscribed": 0,
+			"Bounced": 0,
+			"UniqueOpened": 5,
+			"Mentions": 23,
+			"Forwards": 11,
+			"Likes": 32,
+			"WebVersionURL": "http://createsend.com/t/r-3A433FC72FFE3B8B",
+			"WebVersionTextURL": "http://createsend.com/t/r-3A433FC72FFE3B8B/t",
+			"WorldviewURL": "http://client.createsend.com/reports/wv/r/3A433FC72FFE3B8B",
+			"SpamComplaints": 23
+		}`)
+	})
+
+	summary, err := client.CampaignSummary(context.Background(), "13CD")
+	if err != nil {
+		t.Errorf("CampaignSummary returned error: %v", err)
+	}
+
+	want := &CampaignSummary{
+		Recipients:        5,
+		TotalOpened:       10,
+		UniqueOpened:      5,
+		SpamComplaints:    23,
+		Forwards:          11,
+		Likes:             32,
+		Mentions:          23,
+		WebVersionURL:     "http://createsend.com/t/r-3A433FC72FFE3B8B",
+		WebVersionTextURL: "http://createsend.com/t/r-3A433FC72FFE3B8B/t",
+		WorldviewURL:      "http://client.createsend.com/reports/wv/r/3A433FC72FFE3B8B",
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("CampaignSummary returned %+v, want %+v", summary, want)
+	}
+}
+
+func TestCampaignEmailClientUsage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/emailclientusage.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"Client": "iOS Devices", "Version": "iPhone", "Percentage": 19.83, "Subscribers": 7056},
+			{"Client": "Apple Mail", "Version": "Apple Mail 6", "Percentage": 13.02, "Subscribers": 4633}
+		]`)
+	})
+
+	usage, err := client.CampaignEmailClientUsage(context.Background(), "13CD")
+	if err != nil {
+		t.Errorf("CampaignEmailClientUsage returned error: %v", err)
+	}
+
+	want := []*EmailClientUsage{
+		{Client: "iOS Devices", Version: "iPhone", Percentage: 19.83, Subscribers: 7056},
+		{Client: "Apple Mail", Version: "Apple Mail 6", Percentage: 13.02, Subscribers: 4633},
+	}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("CampaignEmailClientUsage returned %+v, want %+v", usage, want)
+	}
+}
+
+func TestCampaignListsAndSegments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/listsandsegments.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"Lists": [{"ListID": "34cd", "Name": "Website Subscribers"}],
+			"Segments": [{"ListID": "34cd", "SegmentID": "56ef", "Title": "Active"}]
+		}`)
+	})
+
+	ls, err := client.CampaignListsAndSegments(context.Background(), "13CD")
+	if err != nil {
+		t.Errorf("CampaignListsAndSegments returned error: %v", err)
+	}
+
+	want := &CampaignListsAndSegments{
+		Lists:    []*List{{ListID: "34cd", Name: "Website Subscribers"}},
+		Segments: []ListSegment{{ListID: "34cd", SegmentID: "56ef", Title: "Active"}},
+	}
+	if !reflect.DeepEqual(ls, want) {
+		t.Errorf("CampaignListsAndSegments returned %+v, want %+v", ls, want)
+	}
+}
+
+func TestCampaignOpens(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/opens.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "date=2010-01-01+10%3A30&orderdirection=asc&orderfield=date&page=1&pagesize=50")
+		fmt.Fprint(w, `{
+			"Results": [
+				{
+					"EmailAddress": "subs+6576576576@example.com",
+					"ListID": "512a3bc577a58fdf689c654329b50fa0",
+					"Date": "2010-10-11 08:29:00",
+					"IPAddress": "192.168.126.87",
+					"Latitude": -33.8683,
+					"Longitude": 151.2086,
+					"City": "Sydney",
+					"Region": "New South Wales",
+					"CountryCode": "AU",
+					"CountryName": "Australia"
+				}
+			],
+			"ResultsOrderedBy": "date",
+			"OrderDirection": "asc",
+			"PageNumber": 1,
+			"PageSize": 50,
+			"RecordsOnThisPage": 1,
+			"TotalNumberOfRecords": 1,
+			"NumberOfPages": 1
+		}`)
+	})
+
+	opt := &CampaignActivityOptions{
+		Date:           time.Date(2010, time.January, 1, 10, 30, 0, 0, time.UTC),
+		Page:           1,
+		PageSize:       50,
+		OrderField:     "date",
+		OrderDirection: "asc",
+	}
+	opens, err := client.CampaignOpens(context.Background(), "13CD", opt)
+	if err != nil {
+		t.Errorf("CampaignOpens returned error: %v", err)
+	}
+
+	want := &CampaignOpensResponse{
+		Results: []*CampaignOpen{
+			{
+				EmailAddress: "subs+6576576576@example.com",
+				ListID:       "512a3bc577a58fdf689c654329b50fa0",
+				Date:         "2010-10-11 08:29:00",
+				IPAddress:    "192.168.126.87",
+				Latitude:     -33.8683,
+				Longitude:    151.2086,
+				City:         "Sydney",
+				Region:       "New South Wales",
+				CountryCode:  "AU",
+				CountryName:  "Australia",
+			},
+		},
+		ResultsOrderedBy:     "date",
+		OrderDirection:       "asc",
+		PageNumber:           1,
+		PageSize:             50,
+		RecordsOnThisPage:    1,
+		TotalNumberOfRecords: 1,
+		NumberOfPages:        1,
+	}
+	if !reflect.DeepEqual(opens, want) {
+		t.Errorf("CampaignOpens returned %+v, want %+v", opens, want)
+	}
+}
+
+func TestCampaignClicks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/clicks.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "")
+		fmt.Fprint(w, `{"Results": [{"EmailAddress": "a@example.com", "URL": "http://example.com/", "ListID": "34cd", "Date": "2010-10-11 08:29:00"}], "NumberOfPages": 1}`)
+	})
+
+	clicks, err := client.CampaignClicks(context.Background(), "13CD", nil)
+	if err != nil {
+		t.Errorf("CampaignClicks returned error: %v", err)
+	}
+
+	want := []*CampaignClick{{EmailAddress: "a@example.com", URL: "http://example.com/", ListID: "34cd", Date: "2010-10-11 08:29:00"}}
+	if !reflect.DeepEqual(clicks.Results, want) {
+		t.Errorf("CampaignClicks returned %+v, want %+v", clicks.Results, want)
+	}
+}
+
+func TestCampaignBounces(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/bounces.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"Results": [{"EmailAddress": "a@example.com", "ListID": "34cd", "BounceType": "Soft", "Date": "2010-10-11 08:29:00", "Reason": "Soft Bounce - Mailbox Full"}], "NumberOfPages": 1}`)
+	})
+
+	bounces, err := client.CampaignBounces(context.Background(), "13CD", nil)
+	if err != nil {
+		t.Errorf("CampaignBounces returned error: %v", err)
+	}
+
+	want := []*CampaignBounce{{EmailAddress: "a@example.com", ListID: "34cd", BounceType: "Soft", Date: "2010-10-11 08:29:00", Reason: "Soft Bounce - Mailbox Full"}}
+	if !reflect.DeepEqual(bounces.Results, want) {
+		t.Errorf("CampaignBounces returned %+v, want %+v", bounces.Results, want)
+	}
+}
+
+func TestCampaignUnsubscribes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/unsubscribes.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"Results": [{"EmailAddress": "a@example.com", "ListID": "34cd", "Date": "2010-10-11 08:29:00", "IPAddress": "192.168.126.87"}], "NumberOfPages": 1}`)
+	})
+
+	unsubs, err := client.CampaignUnsubscribes(context.Background(), "13CD", nil)
+	if err != nil {
+		t.Errorf("CampaignUnsubscribes returned error: %v", err)
+	}
+
+	want := []*CampaignUnsubscribe{{EmailAddress: "a@example.com", ListID: "34cd", Date: "2010-10-11 08:29:00", IPAddress: "192.168.126.87"}}
+	if !reflect.DeepEqual(unsubs.Results, want) {
+		t.Errorf("CampaignUnsubscribes returned %+v, want %+v", unsubs.Results, want)
+	}
+}
+
+func TestCampaignSpamComplaints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/spam.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"Results": [{"EmailAddress": "a@example.com", "ListID": "34cd", "Date": "2010-10-11 08:29:00"}], "NumberOfPages": 1}`)
+	})
+
+	spam, err := client.CampaignSpamComplaints(context.Background(), "13CD", nil)
+	if err != nil {
+		t.Errorf("CampaignSpamComplaints returned error: %v", err)
+	}
+
+	want := []*CampaignSpamComplaint{{EmailAddress: "a@example.com", ListID: "34cd", Date: "2010-10-11 08:29:00"}}
+	if !reflect.DeepEqual(spam.Results, want) {
+		t.Errorf("CampaignSpamComplaints returned %+v, want %+v", spam.Results, want)
+	}
+}
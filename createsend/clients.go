@@ -1,6 +1,11 @@
 package createsend
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
 
 // A Client represents a client of a Campaign Monitor account.
 //
@@ -15,7 +20,7 @@ type Client struct {
 //
 // See http://www.campaignmonitor.com/api/account/#getting_your_clients for more
 // information.
-func (c *APIClient) ListClients() ([]Client, error) {
+func (c *APIClient) ListClients(ctx context.Context) ([]Client, error) {
 	u := "clients.json"
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -24,7 +29,7 @@ func (c *APIClient) ListClients() ([]Client, error) {
 	}
 
 	clients := new([]Client)
-	err = c.Do(req, clients)
+	err = c.Do(ctx, req, clients)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +41,7 @@ func (c *APIClient) ListClients() ([]Client, error) {
 //
 // See http://www.campaignmonitor.com/api/clients/#subscriber_lists for more
 // information.
-func (c *APIClient) ListLists(clientID string) ([]*List, error) {
+func (c *APIClient) ListLists(ctx context.Context, clientID string) ([]*List, error) {
 	u := fmt.Sprintf("clients/%s/lists.json", clientID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -45,7 +50,7 @@ func (c *APIClient) ListLists(clientID string) ([]*List, error) {
 	}
 
 	var lists []*List
-	err = c.Do(req, &lists)
+	err = c.Do(ctx, req, &lists)
 	return lists, err
 }
 
@@ -74,7 +79,7 @@ func (e *ListForEmail) IsUnsubscribed() bool {
 //
 // See http://www.campaignmonitor.com/api/clients/#lists_for_email for more
 // information.
-func (c *APIClient) ListsForEmail(clientID string, email string) ([]*ListForEmail, error) {
+func (c *APIClient) ListsForEmail(ctx context.Context, clientID string, email string) ([]*ListForEmail, error) {
 	u := fmt.Sprintf("clients/%s/listsforemail.json?email=%s", clientID, email)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -83,7 +88,7 @@ func (c *APIClient) ListsForEmail(clientID string, email string) ([]*ListForEmai
 	}
 
 	var lists []*ListForEmail
-	err = c.Do(req, &lists)
+	err = c.Do(ctx, req, &lists)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +113,7 @@ type Campaign struct {
 //
 // See https://www.campaignmonitor.com/api/clients/#sent_campaigns for more
 // information.
-func (c *APIClient) Campaigns(clientID string) ([]*Campaign, error) {
+func (c *APIClient) Campaigns(ctx context.Context, clientID string) ([]*Campaign, error) {
 	u := fmt.Sprintf("clients/%s/campaigns.json", clientID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -117,10 +122,421 @@ func (c *APIClient) Campaigns(clientID string) ([]*Campaign, error) {
 	}
 
 	var campaigns []*Campaign
-	err = c.Do(req, &campaigns)
+	err = c.Do(ctx, req, &campaigns)
 	if err != nil {
 		return nil, err
 	}
 
 	return campaigns, err
 }
+
+// ScheduledCampaign represents a campaign that is scheduled to be sent.
+//
+// See https://www.campaignmonitor.com/api/clients/#scheduled_campaigns for
+// more information.
+type ScheduledCampaign struct {
+	DateScheduled     string `json:"DateScheduled"`
+	ScheduledTimeZone string `json:"ScheduledTimeZone"`
+	CampaignID        string `json:"CampaignID"`
+	Name              string `json:"Name"`
+	Subject           string `json:"Subject"`
+	FromName          string `json:"FromName"`
+	FromEmail         string `json:"FromEmail"`
+	ReplyTo           string `json:"ReplyTo"`
+	DateCreated       string `json:"DateCreated"`
+	PreviewURL        string `json:"PreviewURL"`
+	PreviewTextURL    string `json:"PreviewTextURL"`
+}
+
+// ScheduledCampaigns returns all the currently scheduled campaigns for a
+// specific client.
+//
+// See https://www.campaignmonitor.com/api/clients/#scheduled_campaigns for
+// more information.
+func (c *APIClient) ScheduledCampaigns(ctx context.Context, clientID string) ([]*ScheduledCampaign, error) {
+	u := fmt.Sprintf("clients/%s/scheduled.json", clientID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var campaigns []*ScheduledCampaign
+	err = c.Do(ctx, req, &campaigns)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaigns, err
+}
+
+// DraftCampaign represents a campaign that has been created but not yet sent
+// or scheduled.
+//
+// See https://www.campaignmonitor.com/api/clients/#draft_campaigns for more
+// information.
+type DraftCampaign struct {
+	CampaignID     string `json:"CampaignID"`
+	Name           string `json:"Name"`
+	Subject        string `json:"Subject"`
+	FromName       string `json:"FromName"`
+	FromEmail      string `json:"FromEmail"`
+	ReplyTo        string `json:"ReplyTo"`
+	DateCreated    string `json:"DateCreated"`
+	PreviewURL     string `json:"PreviewURL"`
+	PreviewTextURL string `json:"PreviewTextURL"`
+}
+
+// DraftCampaigns returns all the draft campaigns for a specific client.
+//
+// See https://www.campaignmonitor.com/api/clients/#draft_campaigns for more
+// information.
+func (c *APIClient) DraftCampaigns(ctx context.Context, clientID string) ([]*DraftCampaign, error) {
+	u := fmt.Sprintf("clients/%s/drafts.json", clientID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var campaigns []*DraftCampaign
+	err = c.Do(ctx, req, &campaigns)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaigns, err
+}
+
+// ClientTemplates returns all the templates that belong to a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#templates for more
+// information.
+func (c *APIClient) ClientTemplates(ctx context.Context, clientID string) ([]*Template, error) {
+	u := fmt.Sprintf("clients/%s/templates.json", clientID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []*Template
+	err = c.Do(ctx, req, &templates)
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// ClientBasics represents the basic details of a client, as used to create a
+// client with CreateClient or update it with SetClientBasics.
+//
+// See https://www.campaignmonitor.com/api/clients/#creating_a_client for more
+// information.
+type ClientBasics struct {
+	CompanyName string `json:"CompanyName"`
+	Country     string `json:"Country"`
+	TimeZone    string `json:"TimeZone"`
+}
+
+// CreateClient creates a new client and returns its ID.
+//
+// See https://www.campaignmonitor.com/api/clients/#creating_a_client for more
+// information.
+func (c *APIClient) CreateClient(ctx context.Context, basics *ClientBasics) (string, error) {
+	u := "clients.json"
+
+	req, err := c.NewRequest("POST", u, basics)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = c.Do(ctx, req, &id)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// ClientDetails represents the complete details of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#getting_a_client for more
+// information.
+type ClientDetails struct {
+	ApiKey         string               `json:"ApiKey"`
+	BasicDetails   ClientBasicDetails   `json:"BasicDetails"`
+	BillingDetails ClientBillingDetails `json:"BillingDetails"`
+}
+
+type ClientBasicDetails struct {
+	ClientID    string `json:"ClientID"`
+	CompanyName string `json:"CompanyName"`
+	Country     string `json:"Country"`
+	TimeZone    string `json:"TimeZone"`
+}
+
+type ClientBillingDetails struct {
+	CanPurchaseCredits     bool    `json:"CanPurchaseCredits"`
+	Credits                int     `json:"Credits"`
+	ClientPays             bool    `json:"ClientPays"`
+	Currency               string  `json:"Currency"`
+	BaseRatePerRecipient   float64 `json:"BaseRatePerRecipient"`
+	MarkupPerRecipient     float64 `json:"MarkupPerRecipient"`
+	BaseDeliveryRate       float64 `json:"BaseDeliveryRate"`
+	MarkupOnDelivery       float64 `json:"MarkupOnDelivery"`
+	BaseDesignSpamTestRate float64 `json:"BaseDesignSpamTestRate"`
+	MarkupOnDesignSpamTest float64 `json:"MarkupOnDesignSpamTest"`
+	MonthlyScheme          string  `json:"MonthlyScheme,omitempty"`
+}
+
+// ClientDetails returns the complete details of a client, including its API
+// key and billing details.
+//
+// See https://www.campaignmonitor.com/api/clients/#getting_a_client for more
+// information.
+func (c *APIClient) ClientDetails(ctx context.Context, clientID string) (*ClientDetails, error) {
+	u := fmt.Sprintf("clients/%s.json", clientID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var details ClientDetails
+	err = c.Do(ctx, req, &details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// SetClientBasics updates the basic details of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_basic_details for
+// more information.
+func (c *APIClient) SetClientBasics(ctx context.Context, clientID string, basics *ClientBasics) error {
+	u := fmt.Sprintf("clients/%s/setbasics.json", clientID)
+
+	req, err := c.NewRequest("PUT", u, basics)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// PAYGBilling represents the pay-as-you-go billing settings of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_payg_billing for
+// more information.
+type PAYGBilling struct {
+	Currency               string  `json:"Currency"`
+	CanPurchaseCredits     bool    `json:"CanPurchaseCredits"`
+	ClientPays             bool    `json:"ClientPays"`
+	MarkupPercentage       int     `json:"MarkupPercentage"`
+	MarkupOnDelivery       float64 `json:"MarkupOnDelivery,omitempty"`
+	MarkupPerRecipient     float64 `json:"MarkupPerRecipient,omitempty"`
+	MarkupOnDesignSpamTest float64 `json:"MarkupOnDesignSpamTest,omitempty"`
+}
+
+// SetPAYGBilling sets a client to pay-as-you-go billing.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_payg_billing for
+// more information.
+func (c *APIClient) SetPAYGBilling(ctx context.Context, clientID string, billing *PAYGBilling) error {
+	u := fmt.Sprintf("clients/%s/setpaygbilling.json", clientID)
+
+	req, err := c.NewRequest("PUT", u, billing)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// MonthlyBilling represents the monthly billing settings of a client.
+// MonthlyScheme is either "Basic" or "Unlimited".
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_monthly_billing
+// for more information.
+type MonthlyBilling struct {
+	Currency         string `json:"Currency"`
+	ClientPays       bool   `json:"ClientPays"`
+	MarkupPercentage int    `json:"MarkupPercentage"`
+	MonthlyScheme    string `json:"MonthlyScheme,omitempty"`
+}
+
+// SetMonthlyBilling sets a client to monthly billing.
+//
+// See https://www.campaignmonitor.com/api/clients/#setting_monthly_billing
+// for more information.
+func (c *APIClient) SetMonthlyBilling(ctx context.Context, clientID string, billing *MonthlyBilling) error {
+	u := fmt.Sprintf("clients/%s/setmonthlybilling.json", clientID)
+
+	req, err := c.NewRequest("PUT", u, billing)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// CreditTransfer represents a transfer of credits between the account and a
+// client. A positive Credits value transfers credits to the client, a
+// negative one transfers them back to the account.
+//
+// See https://www.campaignmonitor.com/api/clients/#transfer_credits for more
+// information.
+type CreditTransfer struct {
+	Credits                       int  `json:"Credits"`
+	CanUseMyCreditsWhenTheyRunOut bool `json:"CanUseMyCreditsWhenTheyRunOut"`
+}
+
+// CreditBalances represents the credit balances after a CreditTransfer.
+type CreditBalances struct {
+	AccountCredits int `json:"AccountCredits"`
+	ClientCredits  int `json:"ClientCredits"`
+}
+
+// TransferCredits transfers credits between the account and a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#transfer_credits for more
+// information.
+func (c *APIClient) TransferCredits(ctx context.Context, clientID string, transfer *CreditTransfer) (*CreditBalances, error) {
+	u := fmt.Sprintf("clients/%s/credits.json", clientID)
+
+	req, err := c.NewRequest("POST", u, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	var balances CreditBalances
+	err = c.Do(ctx, req, &balances)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balances, nil
+}
+
+// DeleteClient deletes a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#deleting_a_client for more
+// information.
+func (c *APIClient) DeleteClient(ctx context.Context, clientID string) error {
+	u := fmt.Sprintf("clients/%s.json", clientID)
+
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// SuppressionListOptions represents the URL parameters that may be used to
+// page through a client's suppression list.
+//
+// See https://www.campaignmonitor.com/api/clients/#suppression_list for more
+// information.
+type SuppressionListOptions struct {
+	Page           int
+	PageSize       int
+	OrderField     string
+	OrderDirection string
+}
+
+// SuppressedSubscriber represents an entry in a client's suppression list.
+type SuppressedSubscriber struct {
+	SuppressionReason string `json:"SuppressionReason"`
+	EmailAddress      string `json:"EmailAddress"`
+	Date              string `json:"Date"`
+	State             string `json:"State"`
+}
+
+type SuppressionListResponse struct {
+	Results              []*SuppressedSubscriber
+	ResultsOrderedBy     string
+	OrderDirection       string
+	PageNumber           int
+	PageSize             int
+	RecordsOnThisPage    int
+	TotalNumberOfRecords int
+	NumberOfPages        int
+}
+
+// SuppressionList returns a page of a client's suppression list.
+//
+// See https://www.campaignmonitor.com/api/clients/#suppression_list for more
+// information.
+func (c *APIClient) SuppressionList(ctx context.Context, clientID string, opt *SuppressionListOptions) (*SuppressionListResponse, error) {
+	u := fmt.Sprintf("clients/%s/suppressionlist.json", clientID)
+
+	if opt != nil {
+		v := url.Values{}
+		if opt.Page > 0 {
+			v.Set("page", strconv.Itoa(opt.Page))
+		}
+		if opt.PageSize > 0 {
+			v.Set("pagesize", strconv.Itoa(opt.PageSize))
+		}
+		if opt.OrderField != "" {
+			v.Set("orderfield", opt.OrderField)
+		}
+		if opt.OrderDirection != "" {
+			v.Set("orderdirection", opt.OrderDirection)
+		}
+
+		q := v.Encode()
+		if q != "" {
+			u = fmt.Sprintf("%s?%s", u, q)
+		}
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results SuppressionListResponse
+	err = c.Do(ctx, req, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+// Suppress adds email addresses to a client's suppression list.
+//
+// See https://www.campaignmonitor.com/api/clients/#suppress_email_addresses
+// for more information.
+func (c *APIClient) Suppress(ctx context.Context, clientID string, emails []string) error {
+	u := fmt.Sprintf("clients/%s/suppress.json", clientID)
+
+	req, err := c.NewRequest("POST", u, struct{ EmailAddresses []string }{emails})
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// Unsuppress removes an email address from a client's suppression list.
+//
+// See https://www.campaignmonitor.com/api/clients/#unsuppress_an_email for
+// more information.
+func (c *APIClient) Unsuppress(ctx context.Context, clientID string, email string) error {
+	u := fmt.Sprintf("clients/%s/unsuppress.json?email=%s", clientID, url.QueryEscape(email))
+
+	req, err := c.NewRequest("PUT", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
@@ -1,6 +1,7 @@
 package createsend
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -16,7 +17,7 @@ func TestListClients(t *testing.T) {
 		fmt.Fprint(w, `[{"ClientID": "12ab", "Name": "Alice"}]`)
 	})
 
-	clients, err := client.ListClients()
+	clients, err := client.ListClients(context.Background())
 	if err != nil {
 		t.Errorf("ListClients returned error: %v", err)
 	}
@@ -36,7 +37,7 @@ func TestListLists(t *testing.T) {
 		fmt.Fprint(w, `[{"ListID": "34cd", "Name": "mylist"}]`)
 	})
 
-	lists, err := client.ListLists("12ab")
+	lists, err := client.ListLists(context.Background(), "12ab")
 	if err != nil {
 		t.Errorf("ListLists returned error: %v", err)
 	}
@@ -57,7 +58,7 @@ func TestListsForEmail(t *testing.T) {
 		fmt.Fprint(w, `[{"ListID": "34cd", "ListName": "mylist", "SubscriberState": "Active"}]`)
 	})
 
-	lists, err := client.ListsForEmail("12ab", "alice@example.com")
+	lists, err := client.ListsForEmail(context.Background(), "12ab", "alice@example.com")
 	if err != nil {
 		t.Errorf("ListsForEmail returned error: %v", err)
 	}
@@ -103,7 +104,7 @@ func TestCampaigns(t *testing.T) {
 				]`)
 	})
 
-	campaigns, err := client.Campaigns("12ab")
+	campaigns, err := client.Campaigns(context.Background(), "12ab")
 	if err != nil {
 		t.Errorf("Campaigns returned error: %v", err)
 	}
@@ -139,3 +140,376 @@ func TestCampaigns(t *testing.T) {
 		t.Errorf("Campaigns return %+v, want %+v", campaigns, want)
 	}
 }
+
+func TestScheduledCampaigns(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/scheduled.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `
+				[
+					{
+						"DateScheduled": "2011-05-25 10:40:00",
+						"ScheduledTimeZone": "(GMT+10:00) Canberra, Melbourne, Sydney",
+						"CampaignID": "827dbbd2161ea9989fa11ad562c66937",
+						"Name": "Magic Issue One",
+						"Subject": "Magic Issue One",
+						"FromName": "My Name",
+						"FromEmail": "myemail@example.com",
+						"ReplyTo": "myemail@example.com",
+						"DateCreated": "2011-05-24 10:37:00",
+						"PreviewURL": "http://createsend.com/t/r-DD543521A87C9B8B",
+						"PreviewTextURL": "http://createsend.com/t/r-DD543521A87C9B8B/t"
+					}
+				]`)
+	})
+
+	campaigns, err := client.ScheduledCampaigns(context.Background(), "12ab")
+	if err != nil {
+		t.Errorf("ScheduledCampaigns returned error: %v", err)
+	}
+
+	want := []*ScheduledCampaign{
+		{
+			DateScheduled:     "2011-05-25 10:40:00",
+			ScheduledTimeZone: "(GMT+10:00) Canberra, Melbourne, Sydney",
+			CampaignID:        "827dbbd2161ea9989fa11ad562c66937",
+			Name:              "Magic Issue One",
+			Subject:           "Magic Issue One",
+			FromName:          "My Name",
+			FromEmail:         "myemail@example.com",
+			ReplyTo:           "myemail@example.com",
+			DateCreated:       "2011-05-24 10:37:00",
+			PreviewURL:        "http://createsend.com/t/r-DD543521A87C9B8B",
+			PreviewTextURL:    "http://createsend.com/t/r-DD543521A87C9B8B/t",
+		},
+	}
+	if !reflect.DeepEqual(campaigns, want) {
+		t.Errorf("ScheduledCampaigns returned %+v, want %+v", campaigns, want)
+	}
+}
+
+func TestDraftCampaigns(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/drafts.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `
+				[
+					{
+						"CampaignID": "7c7424792065d92627139208c8c01db1",
+						"Name": "Draft One",
+						"Subject": "Draft One",
+						"FromName": "My Name",
+						"FromEmail": "myemail@example.com",
+						"ReplyTo": "myemail@example.com",
+						"DateCreated": "2010-08-19 16:08:00",
+						"PreviewURL": "http://createsend.com/t/r-E97A7BB2E6983DA1",
+						"PreviewTextURL": "http://createsend.com/t/r-E97A7BB2E6983DA1/t"
+					}
+				]`)
+	})
+
+	campaigns, err := client.DraftCampaigns(context.Background(), "12ab")
+	if err != nil {
+		t.Errorf("DraftCampaigns returned error: %v", err)
+	}
+
+	want := []*DraftCampaign{
+		{
+			CampaignID:     "7c7424792065d92627139208c8c01db1",
+			Name:           "Draft One",
+			Subject:        "Draft One",
+			FromName:       "My Name",
+			FromEmail:      "myemail@example.com",
+			ReplyTo:        "myemail@example.com",
+			DateCreated:    "2010-08-19 16:08:00",
+			PreviewURL:     "http://createsend.com/t/r-E97A7BB2E6983DA1",
+			PreviewTextURL: "http://createsend.com/t/r-E97A7BB2E6983DA1/t",
+		},
+	}
+	if !reflect.DeepEqual(campaigns, want) {
+		t.Errorf("DraftCampaigns returned %+v, want %+v", campaigns, want)
+	}
+}
+
+func TestClientTemplates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/templates.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"TemplateID": "5cac213cf061d1d9a5e5d3ac4e0bd5b4", "Name": "Template One", "PreviewURL": "http://preview.createsend.com/templates/publicPreview/01AF532CD8889B33?d=r", "ScreenshotURL": "http://preview.createsend.com/ts/r/14/833/263/14833263.jpg?0318092600"}]`)
+	})
+
+	templates, err := client.ClientTemplates(context.Background(), "12ab")
+	if err != nil {
+		t.Errorf("ClientTemplates returned error: %v", err)
+	}
+
+	want := []*Template{{
+		TemplateID:    "5cac213cf061d1d9a5e5d3ac4e0bd5b4",
+		Name:          "Template One",
+		PreviewURL:    "http://preview.createsend.com/templates/publicPreview/01AF532CD8889B33?d=r",
+		ScreenshotURL: "http://preview.createsend.com/ts/r/14/833/263/14833263.jpg?0318092600",
+	}}
+	if !reflect.DeepEqual(templates, want) {
+		t.Errorf("ClientTemplates returned %+v, want %+v", templates, want)
+	}
+}
+
+func TestCreateClient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"CompanyName":"ACME","Country":"Australia","TimeZone":"(GMT+10:00) Canberra, Melbourne, Sydney"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `"12ab"`)
+	})
+
+	id, err := client.CreateClient(context.Background(), &ClientBasics{
+		CompanyName: "ACME",
+		Country:     "Australia",
+		TimeZone:    "(GMT+10:00) Canberra, Melbourne, Sydney",
+	})
+	if err != nil {
+		t.Errorf("CreateClient returned error: %v", err)
+	}
+	if id != "12ab" {
+		t.Errorf("CreateClient returned %q, want %q", id, "12ab")
+	}
+}
+
+func TestClientDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"ApiKey": "639d8cc27198202f5fe6037a8b17a29a59984b86d3289bc9",
+			"BasicDetails": {
+				"ClientID": "12ab",
+				"CompanyName": "ACME",
+				"Country": "Australia",
+				"TimeZone": "(GMT+10:00) Canberra, Melbourne, Sydney"
+			},
+			"BillingDetails": {
+				"CanPurchaseCredits": true,
+				"Credits": 500,
+				"MarkupOnDesignSpamTest": 0.0,
+				"ClientPays": true,
+				"BaseRatePerRecipient": 1.0,
+				"MarkupPerRecipient": 0.0,
+				"MarkupOnDelivery": 0.0,
+				"BaseDeliveryRate": 5.0,
+				"Currency": "USD",
+				"BaseDesignSpamTestRate": 5.0
+			}
+		}`)
+	})
+
+	details, err := client.ClientDetails(context.Background(), "12ab")
+	if err != nil {
+		t.Errorf("ClientDetails returned error: %v", err)
+	}
+
+	want := &ClientDetails{
+		ApiKey: "639d8cc27198202f5fe6037a8b17a29a59984b86d3289bc9",
+		BasicDetails: ClientBasicDetails{
+			ClientID:    "12ab",
+			CompanyName: "ACME",
+			Country:     "Australia",
+			TimeZone:    "(GMT+10:00) Canberra, Melbourne, Sydney",
+		},
+		BillingDetails: ClientBillingDetails{
+			CanPurchaseCredits:     true,
+			Credits:                500,
+			ClientPays:             true,
+			BaseRatePerRecipient:   1,
+			BaseDeliveryRate:       5,
+			Currency:               "USD",
+			BaseDesignSpamTestRate: 5,
+		},
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("ClientDetails returned %+v, want %+v", details, want)
+	}
+}
+
+func TestSetClientBasics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/setbasics.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"CompanyName":"ACME Inc.","Country":"Australia","TimeZone":"(GMT+10:00) Canberra, Melbourne, Sydney"}`+"\n")
+	})
+
+	err := client.SetClientBasics(context.Background(), "12ab", &ClientBasics{
+		CompanyName: "ACME Inc.",
+		Country:     "Australia",
+		TimeZone:    "(GMT+10:00) Canberra, Melbourne, Sydney",
+	})
+	if err != nil {
+		t.Errorf("SetClientBasics returned error: %v", err)
+	}
+}
+
+func TestSetPAYGBilling(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/setpaygbilling.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"Currency":"AUD","CanPurchaseCredits":true,"ClientPays":true,"MarkupPercentage":20,"MarkupOnDelivery":4.5}`+"\n")
+	})
+
+	err := client.SetPAYGBilling(context.Background(), "12ab", &PAYGBilling{
+		Currency:           "AUD",
+		CanPurchaseCredits: true,
+		ClientPays:         true,
+		MarkupPercentage:   20,
+		MarkupOnDelivery:   4.5,
+	})
+	if err != nil {
+		t.Errorf("SetPAYGBilling returned error: %v", err)
+	}
+}
+
+func TestSetMonthlyBilling(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/setmonthlybilling.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"Currency":"USD","ClientPays":true,"MarkupPercentage":150,"MonthlyScheme":"Basic"}`+"\n")
+	})
+
+	err := client.SetMonthlyBilling(context.Background(), "12ab", &MonthlyBilling{
+		Currency:         "USD",
+		ClientPays:       true,
+		MarkupPercentage: 150,
+		MonthlyScheme:    "Basic",
+	})
+	if err != nil {
+		t.Errorf("SetMonthlyBilling returned error: %v", err)
+	}
+}
+
+func TestTransferCredits(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/credits.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"Credits":200,"CanUseMyCreditsWhenTheyRunOut":false}`+"\n")
+		fmt.Fprint(w, `{"AccountCredits": 800, "ClientCredits": 200}`)
+	})
+
+	balances, err := client.TransferCredits(context.Background(), "12ab", &CreditTransfer{Credits: 200})
+	if err != nil {
+		t.Errorf("TransferCredits returned error: %v", err)
+	}
+
+	want := &CreditBalances{AccountCredits: 800, ClientCredits: 200}
+	if !reflect.DeepEqual(balances, want) {
+		t.Errorf("TransferCredits returned %+v, want %+v", balances, want)
+	}
+}
+
+func TestDeleteClient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	err := client.DeleteClient(context.Background(), "12ab")
+	if err != nil {
+		t.Errorf("DeleteClient returned error: %v", err)
+	}
+}
+
+func TestSuppressionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/suppressionlist.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "orderdirection=desc&orderfield=email&page=2&pagesize=10")
+		fmt.Fprint(w, `{
+			"Results": [
+				{
+					"SuppressionReason": "Unsubscribed",
+					"EmailAddress": "example+1@example.com",
+					"Date": "2010-10-26 10:55:31",
+					"State": "Suppressed"
+				}
+			],
+			"ResultsOrderedBy": "email",
+			"OrderDirection": "desc",
+			"PageNumber": 2,
+			"PageSize": 10,
+			"RecordsOnThisPage": 1,
+			"TotalNumberOfRecords": 11,
+			"NumberOfPages": 2
+		}`)
+	})
+
+	res, err := client.SuppressionList(context.Background(), "12ab", &SuppressionListOptions{Page: 2, PageSize: 10, OrderField: "email", OrderDirection: "desc"})
+	if err != nil {
+		t.Errorf("SuppressionList returned error: %v", err)
+	}
+
+	want := &SuppressionListResponse{
+		Results: []*SuppressedSubscriber{
+			{SuppressionReason: "Unsubscribed", EmailAddress: "example+1@example.com", Date: "2010-10-26 10:55:31", State: "Suppressed"},
+		},
+		ResultsOrderedBy:     "email",
+		OrderDirection:       "desc",
+		PageNumber:           2,
+		PageSize:             10,
+		RecordsOnThisPage:    1,
+		TotalNumberOfRecords: 11,
+		NumberOfPages:        2,
+	}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("SuppressionList returned %+v, want %+v", res, want)
+	}
+}
+
+func TestSuppress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/suppress.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"EmailAddresses":["a@example.com","b@example.com"]}`+"\n")
+	})
+
+	err := client.Suppress(context.Background(), "12ab", []string{"a@example.com", "b@example.com"})
+	if err != nil {
+		t.Errorf("Suppress returned error: %v", err)
+	}
+}
+
+func TestUnsuppress(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12ab/unsuppress.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testQuerystring(t, r, "email=example%2B1%40example.com")
+	})
+
+	err := client.Unsuppress(context.Background(), "12ab", "example+1@example.com")
+	if err != nil {
+		t.Errorf("Unsuppress returned error: %v", err)
+	}
+}
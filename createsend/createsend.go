@@ -2,12 +2,14 @@ package createsend
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 const (
@@ -29,6 +31,19 @@ type APIClient struct {
 
 	// Log is used to log debugging messages, if set.
 	Log *log.Logger
+
+	// Retry configures automatic retries of failed requests. If nil, requests
+	// are sent only once.
+	Retry *RetryPolicy
+
+	// BlockOnRateLimit, if set, makes requests wait for the rate limit window
+	// to reset once the requests remaining in it (as reported by the
+	// X-RateLimit-* response headers) have been used up, instead of sending
+	// them and receiving HTTP 429 responses. It is safe to share the APIClient
+	// between goroutines: they draw from the same quota.
+	BlockOnRateLimit bool
+
+	rate rateLimiter
 }
 
 // NewAPIClient returns a new Campaign Monitor API client. If a nil httpClient
@@ -75,47 +90,90 @@ func (c *APIClient) NewRequest(method, urlStr string, body interface{}) (*http.R
 	return req, nil
 }
 
-type CreatesendError struct {
-	Code       int
-	Message    string
-	ResultData interface{}
-}
-
-func (e *CreatesendError) Error() string {
-	return fmt.Sprintf("%s (createsend error %d)", e.Message, e.Code)
-}
-
 // Do sends an API request and returns the API response. The API response is
 // decoded and stored in the value pointed to by v, or returned as an error if
-// an API error has occurred.
-func (c *APIClient) Do(req *http.Request, v interface{}) error {
-	resp, err := c.client.Do(req)
+// an API error has occurred. API errors are of type *ErrorResponse.
+//
+// The provided ctx must be non-nil. If it is canceled or times out, the
+// in-flight request is aborted and ctx.Err() is returned.
+func (c *APIClient) Do(ctx context.Context, req *http.Request, v interface{}) error {
+	req = req.WithContext(ctx)
+
+	resp, err := c.send(ctx, req)
 	if err != nil {
 		return err
 	}
 
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusBadRequest {
-		var e CreatesendError
-		err = json.NewDecoder(resp.Body).Decode(&e)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return c.newErrorResponse(req, resp)
+	}
+
+	if v != nil {
+		err = json.NewDecoder(resp.Body).Decode(v)
+	}
+	return err
+}
+
+// send sends req, retrying it according to c.Retry. The caller must close the
+// returned response's body.
+func (c *APIClient) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if c.BlockOnRateLimit {
+			if err := c.rate.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.client.Do(req)
 		if err != nil {
-			return err
+			// If we got an error and the context has been canceled, the
+			// context's error is probably more useful.
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+		} else if rl, ok := parseRateLimit(resp.Header, time.Now()); ok {
+			c.rate.update(rl)
+		}
+
+		wait, retry := c.Retry.retryDelay(req, resp, err, attempt)
+		if !retry {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+			if c.Log != nil {
+				c.Log.Printf("%s %s: http response %d, retrying in %s", req.Method, req.URL, resp.StatusCode, wait)
+			}
+		} else if c.Log != nil {
+			c.Log.Printf("%s %s: %s, retrying in %s", req.Method, req.URL, err, wait)
 		}
-		return &e
-	} else if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		if c.Log != nil {
-			body, err := ioutil.ReadAll(resp.Body)
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
 			if err != nil {
-				log.Printf("ReadAll failed: %s")
+				return nil, err
 			}
-			c.Log.Printf("http response %d body:\n%s", resp.StatusCode, body)
 		}
-		return fmt.Errorf("http response status code %d", resp.StatusCode)
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
+}
 
-	if v != nil {
-		err = json.NewDecoder(resp.Body).Decode(v)
+// sleep waits for d to elapse, returning early with ctx.Err() if ctx is done
+// first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-	return err
 }
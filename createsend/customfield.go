@@ -0,0 +1,217 @@
+package createsend
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// customFieldDateFormat is the format of Date custom field values.
+const customFieldDateFormat = "2006/01/02"
+
+// NewTextField returns a Text custom field value.
+func NewTextField(key, value string) CustomField {
+	return CustomField{Key: key, Value: value}
+}
+
+// NewNumberField returns a Number custom field value.
+func NewNumberField(key string, value float64) CustomField {
+	return CustomField{Key: key, Value: value}
+}
+
+// NewDateField returns a Date custom field value. Only the date of value is
+// kept.
+func NewDateField(key string, value time.Time) CustomField {
+	return CustomField{Key: key, Value: value.Format(customFieldDateFormat)}
+}
+
+// NewMultiSelectManyFields returns the custom field values that select each
+// of values in a MultiSelectMany field. The API represents them as repeated
+// entries with the same key.
+func NewMultiSelectManyFields(key string, values ...string) []CustomField {
+	fields := make([]CustomField, len(values))
+	for i, v := range values {
+		fields[i] = CustomField{Key: key, Value: v}
+	}
+	return fields
+}
+
+// NewCountryField returns a Country custom field value, such as "Australia".
+func NewCountryField(key, value string) CustomField {
+	return CustomField{Key: key, Value: value}
+}
+
+// NewUSStateField returns a USState custom field value, such as
+// "California".
+func NewUSStateField(key, value string) CustomField {
+	return CustomField{Key: key, Value: value}
+}
+
+// ClearField returns a custom field that removes the field's value when
+// updating a subscriber.
+func ClearField(key string) CustomField {
+	return CustomField{Key: key, Value: "", Clear: true}
+}
+
+// TextValue returns the value of a Text, MultiSelectOne, Country or USState
+// field, or of one entry of a MultiSelectMany field.
+func (f CustomField) TextValue() (string, error) {
+	switch v := f.Value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case nil:
+		return "", nil
+	}
+	return "", fmt.Errorf("custom field %s: unexpected value %v (%T)", f.Key, f.Value, f.Value)
+}
+
+// NumberValue returns the value of a Number field. The API returns numbers as
+// strings, which are parsed.
+func (f CustomField) NumberValue() (float64, error) {
+	switch v := f.Value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("custom field %s: %w", f.Key, err)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("custom field %s: unexpected value %v (%T)", f.Key, f.Value, f.Value)
+}
+
+// DateValue returns the value of a Date field.
+func (f CustomField) DateValue() (time.Time, error) {
+	s, ok := f.Value.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("custom field %s: unexpected value %v (%T)", f.Key, f.Value, f.Value)
+	}
+	t, err := time.Parse(customFieldDateFormat, s)
+	if err != nil {
+		if t, err2 := time.Parse("2006-01-02", s); err2 == nil {
+			return t, nil
+		}
+		return time.Time{}, fmt.Errorf("custom field %s: %w", f.Key, err)
+	}
+	return t, nil
+}
+
+// sameCustomFieldKey reports whether a and b are the same custom field key.
+// Subscribers' custom fields are keyed by the bare name ("website"), while
+// CustomFieldDefinition.Key is bracketed ("[website]").
+func sameCustomFieldKey(a, b string) bool {
+	return strings.Trim(a, "[]") == strings.Trim(b, "[]")
+}
+
+// CustomField returns the subscriber's value for the custom field described
+// by def, converted according to its DataType: a string for Text,
+// MultiSelectOne, Country and USState fields, a float64 for Number fields, a
+// time.Time for Date fields and a []string for MultiSelectMany fields. It
+// returns nil if the subscriber has no value for the field.
+func (s *Subscriber) CustomField(def CustomFieldDefinition) (interface{}, error) {
+	var values []string
+	for _, f := range s.CustomFields {
+		if !sameCustomFieldKey(f.Key, def.Key) {
+			continue
+		}
+		switch def.DataType {
+		case Number:
+			return f.NumberValue()
+		case Date:
+			return f.DateValue()
+		}
+		v, err := f.TextValue()
+		if err != nil {
+			return nil, err
+		}
+		if def.DataType != MultiSelectMany {
+			return v, nil
+		}
+		values = append(values, v)
+	}
+	if values != nil {
+		return values, nil
+	}
+	return nil, nil
+}
+
+// SetCustomField sets the subscriber's value for the custom field described
+// by def, replacing any existing value. The type of value must match the
+// field's DataType as described for CustomField; options of multi-select
+// fields must be among def.FieldOptions. If value is nil, or an empty
+// []string for a MultiSelectMany field, the field is cleared.
+func (s *Subscriber) SetCustomField(def CustomFieldDefinition, value interface{}) error {
+	key := strings.Trim(def.Key, "[]")
+
+	var fields []CustomField
+	switch v := value.(type) {
+	case nil:
+		fields = []CustomField{ClearField(key)}
+	case string:
+		switch def.DataType {
+		case MultiSelectOne:
+			if err := checkFieldOptions(def, v); err != nil {
+				return err
+			}
+		case Text, Country, USState:
+		default:
+			return fmt.Errorf("custom field %s: cannot set %s field to a string", def.Key, def.DataType)
+		}
+		fields = []CustomField{{Key: key, Value: v}}
+	case float64:
+		if def.DataType != Number {
+			return fmt.Errorf("custom field %s: cannot set %s field to a number", def.Key, def.DataType)
+		}
+		fields = []CustomField{NewNumberField(key, v)}
+	case time.Time:
+		if def.DataType != Date {
+			return fmt.Errorf("custom field %s: cannot set %s field to a date", def.Key, def.DataType)
+		}
+		fields = []CustomField{NewDateField(key, v)}
+	case []string:
+		if def.DataType != MultiSelectMany {
+			return fmt.Errorf("custom field %s: cannot set %s field to multiple options", def.Key, def.DataType)
+		}
+		if err := checkFieldOptions(def, v...); err != nil {
+			return err
+		}
+		if len(v) == 0 {
+			fields = []CustomField{ClearField(key)}
+		} else {
+			fields = NewMultiSelectManyFields(key, v...)
+		}
+	default:
+		return fmt.Errorf("custom field %s: unsupported value type %T", def.Key, value)
+	}
+
+	var kept []CustomField
+	for _, f := range s.CustomFields {
+		if !sameCustomFieldKey(f.Key, key) {
+			kept = append(kept, f)
+		}
+	}
+	s.CustomFields = append(kept, fields...)
+	return nil
+}
+
+// checkFieldOptions returns an error if any of options is not one of the
+// field's options.
+func checkFieldOptions(def CustomFieldDefinition, options ...string) error {
+	for _, o := range options {
+		found := false
+		for _, fo := range def.FieldOptions {
+			if o == fo {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("custom field %s: %q is not an option", def.Key, o)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package createsend
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCustomField_marshal(t *testing.T) {
+	fields := []CustomField{
+		NewTextField("website", "http://example.com"),
+		NewNumberField("age", 42),
+		NewDateField("birthday", time.Date(1980, time.March, 4, 12, 0, 0, 0, time.UTC)),
+		ClearField("color"),
+	}
+	fields = append(fields, NewMultiSelectManyFields("interests", "a", "b")...)
+
+	b, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `[{"Key":"website","Value":"http://example.com"},{"Key":"age","Value":42},{"Key":"birthday","Value":"1980/03/04"},{"Key":"color","Value":"","Clear":true},{"Key":"interests","Value":"a"},{"Key":"interests","Value":"b"}]`
+	if string(b) != want {
+		t.Errorf("json.Marshal returned %s, want %s", b, want)
+	}
+}
+
+func TestSubscriber_CustomField(t *testing.T) {
+	var sub Subscriber
+	err := json.Unmarshal([]byte(`{
+		"EmailAddress": "a@example.com",
+		"CustomFields": [
+			{"Key": "website", "Value": "http://example.com"},
+			{"Key": "age", "Value": "42"},
+			{"Key": "birthday", "Value": "1980/03/04"},
+			{"Key": "interests", "Value": "a"},
+			{"Key": "interests", "Value": "b"}
+		]
+	}`), &sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		def  CustomFieldDefinition
+		want interface{}
+	}{
+		{CustomFieldDefinition{Key: "[website]", DataType: Text}, "http://example.com"},
+		{CustomFieldDefinition{Key: "[age]", DataType: Number}, float64(42)},
+		{CustomFieldDefinition{Key: "[birthday]", DataType: Date}, time.Date(1980, time.March, 4, 0, 0, 0, 0, time.UTC)},
+		{CustomFieldDefinition{Key: "[interests]", DataType: MultiSelectMany}, []string{"a", "b"}},
+		{CustomFieldDefinition{Key: "[missing]", DataType: Text}, nil},
+	}
+	for _, test := range tests {
+		v, err := sub.CustomField(test.def)
+		if err != nil {
+			t.Errorf("CustomField(%s) returned error: %v", test.def.Key, err)
+		}
+		if !reflect.DeepEqual(v, test.want) {
+			t.Errorf("CustomField(%s) returned %#v, want %#v", test.def.Key, v, test.want)
+		}
+	}
+}
+
+func TestSubscriber_SetCustomField(t *testing.T) {
+	sub := &Subscriber{CustomFields: []CustomField{
+		NewTextField("website", "http://example.com"),
+		{Key: "interests", Value: "a"},
+		{Key: "interests", Value: "b"},
+	}}
+	interests := CustomFieldDefinition{Key: "[interests]", DataType: MultiSelectMany, FieldOptions: []string{"a", "b", "c"}}
+
+	if err := sub.SetCustomField(interests, []string{"c"}); err != nil {
+		t.Errorf("SetCustomField returned error: %v", err)
+	}
+	if err := sub.SetCustomField(CustomFieldDefinition{Key: "[website]", DataType: Text}, nil); err != nil {
+		t.Errorf("SetCustomField returned error: %v", err)
+	}
+
+	want := []CustomField{{Key: "interests", Value: "c"}, ClearField("website")}
+	if !reflect.DeepEqual(sub.CustomFields, want) {
+		t.Errorf("SetCustomField set %+v, want %+v", sub.CustomFields, want)
+	}
+
+	if err := sub.SetCustomField(interests, []string{"d"}); err == nil {
+		t.Error("SetCustomField returned no error for an unknown option")
+	}
+	if err := sub.SetCustomField(CustomFieldDefinition{Key: "[age]", DataType: Number}, "42"); err == nil {
+		t.Error("SetCustomField returned no error for a mistyped value")
+	}
+}
+
+func TestSubscriber_SetCustomField_emptyMultiSelectMany(t *testing.T) {
+	sub := &Subscriber{CustomFields: []CustomField{
+		{Key: "interests", Value: "a"},
+		{Key: "interests", Value: "b"},
+	}}
+	interests := CustomFieldDefinition{Key: "[interests]", DataType: MultiSelectMany, FieldOptions: []string{"a", "b"}}
+
+	if err := sub.SetCustomField(interests, []string{}); err != nil {
+		t.Errorf("SetCustomField returned error: %v", err)
+	}
+
+	want := []CustomField{ClearField("interests")}
+	if !reflect.DeepEqual(sub.CustomFields, want) {
+		t.Errorf("SetCustomField set %+v, want %+v", sub.CustomFields, want)
+	}
+}
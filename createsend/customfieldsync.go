@@ -0,0 +1,184 @@
+package createsend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CustomFieldPlan describes the changes needed to make a list's custom fields
+// match a desired schema.
+type CustomFieldPlan struct {
+	Create []*CustomFieldCreate
+	Update []CustomFieldChange
+	Delete []CustomFieldDefinition
+}
+
+// CustomFieldChange is an existing custom field in a CustomFieldPlan whose
+// options or preference center visibility are updated. Either of Options and
+// Visibility may be nil if that part of the field is unchanged.
+type CustomFieldChange struct {
+	Key        string
+	FieldName  string
+	Options    *CustomFieldOptionsUpdate
+	Visibility *CustomFieldUpdate
+}
+
+// PlanCustomFieldSync compares the desired custom fields of a list with its
+// existing (remote) ones, as returned by ListCustomFields. Fields are matched
+// by FieldName or, failing that, by the "[Key]" the API derives from it.
+//
+// Desired fields without a remote counterpart are created. For multi-select
+// fields, missing options are added; when prune is set, options that are not
+// desired are also removed by replacing the field's options. Remote fields
+// that are not desired are deleted only if prune is set, since deleting a
+// field deletes its subscriber data.
+//
+// The API cannot change the DataType of a field, so PlanCustomFieldSync
+// returns an error if a desired field has a different DataType than the
+// existing one.
+func PlanCustomFieldSync(desired []*CustomFieldCreate, remote []CustomFieldDefinition, prune bool) (*CustomFieldPlan, error) {
+	byName := make(map[string]int, len(remote))
+	byKey := make(map[string]int, len(remote))
+	for i, r := range remote {
+		byName[r.FieldName] = i
+		byKey[r.Key] = i
+	}
+
+	plan := &CustomFieldPlan{}
+	matched := make(map[int]bool, len(remote))
+	for _, d := range desired {
+		i, ok := byName[d.FieldName]
+		if !ok {
+			i, ok = byKey[customFieldKey(d.FieldName)]
+		}
+		if !ok {
+			plan.Create = append(plan.Create, d)
+			continue
+		}
+		matched[i] = true
+
+		r := remote[i]
+		if r.DataType != d.DataType {
+			return nil, fmt.Errorf("custom field %q is %s, want %s: the data type of a field cannot be changed", r.FieldName, r.DataType, d.DataType)
+		}
+
+		change := CustomFieldChange{Key: r.Key, FieldName: r.FieldName}
+		if r.VisibleInPreferenceCenter != d.VisibleInPreferenceCenter {
+			change.Visibility = &CustomFieldUpdate{FieldName: r.FieldName, VisibleInPreferenceCenter: d.VisibleInPreferenceCenter}
+		}
+		if r.DataType == MultiSelectOne || r.DataType == MultiSelectMany {
+			missing, extra := diffOptions(r.FieldOptions, d.Options)
+			if prune && len(extra) > 0 {
+				change.Options = &CustomFieldOptionsUpdate{Options: d.Options}
+			} else if len(missing) > 0 {
+				change.Options = &CustomFieldOptionsUpdate{KeepExistingOptions: true, Options: missing}
+			}
+		}
+		if change.Options != nil || change.Visibility != nil {
+			plan.Update = append(plan.Update, change)
+		}
+	}
+	if prune {
+		for i, r := range remote {
+			if !matched[i] {
+				plan.Delete = append(plan.Delete, r)
+			}
+		}
+	}
+
+	sort.Slice(plan.Create, func(i, j int) bool { return plan.Create[i].FieldName < plan.Create[j].FieldName })
+	sort.Slice(plan.Update, func(i, j int) bool { return plan.Update[i].FieldName < plan.Update[j].FieldName })
+	sort.Slice(plan.Delete, func(i, j int) bool { return plan.Delete[i].FieldName < plan.Delete[j].FieldName })
+	return plan, nil
+}
+
+// customFieldKey returns the key the API gives a custom field named name,
+// such as "[FavoriteColor]" for "Favorite Color".
+func customFieldKey(name string) string {
+	return "[" + strings.Replace(name, " ", "", -1) + "]"
+}
+
+// diffOptions returns the options in want that are not in have, and those in
+// have that are not in want.
+func diffOptions(have, want []string) (missing, extra []string) {
+	haveSet := make(map[string]bool, len(have))
+	for _, o := range have {
+		haveSet[o] = true
+	}
+	wantSet := make(map[string]bool, len(want))
+	for _, o := range want {
+		wantSet[o] = true
+		if !haveSet[o] {
+			missing = append(missing, o)
+		}
+	}
+	for _, o := range have {
+		if !wantSet[o] {
+			extra = append(extra, o)
+		}
+	}
+	return missing, extra
+}
+
+// Empty reports whether the plan makes no changes.
+func (p *CustomFieldPlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// String formats the plan as a diff, with one line per custom field prefixed
+// by "+" (create), "~" (update) or "-" (delete).
+func (p *CustomFieldPlan) String() string {
+	var buf bytes.Buffer
+	for _, f := range p.Create {
+		fmt.Fprintf(&buf, "+ %s (%s)\n", f.FieldName, f.DataType)
+	}
+	for _, f := range p.Update {
+		fmt.Fprintf(&buf, "~ %s %s", f.FieldName, f.Key)
+		if o := f.Options; o != nil {
+			if o.KeepExistingOptions {
+				fmt.Fprintf(&buf, " add options %s", strings.Join(o.Options, ", "))
+			} else {
+				fmt.Fprintf(&buf, " set options %s", strings.Join(o.Options, ", "))
+			}
+		}
+		if v := f.Visibility; v != nil {
+			fmt.Fprintf(&buf, " visible=%t", v.VisibleInPreferenceCenter)
+		}
+		buf.WriteByte('\n')
+	}
+	for _, f := range p.Delete {
+		fmt.Fprintf(&buf, "- %s %s\n", f.FieldName, f.Key)
+	}
+	return buf.String()
+}
+
+// ApplyCustomFieldPlan makes the changes described by plan to a list's custom
+// fields. It stops at the first error.
+func (c *APIClient) ApplyCustomFieldPlan(ctx context.Context, listID string, plan *CustomFieldPlan) error {
+	for _, f := range plan.Create {
+		if _, err := c.ListCreateCustomField(ctx, listID, f); err != nil {
+			return fmt.Errorf("creating custom field %q: %w", f.FieldName, err)
+		}
+	}
+	for _, f := range plan.Update {
+		if f.Options != nil {
+			if err := c.ListUpdateCustomFieldOptions(ctx, listID, f.Key, f.Options); err != nil {
+				return fmt.Errorf("updating options of custom field %q: %w", f.FieldName, err)
+			}
+		}
+		if f.Visibility != nil {
+			if _, err := c.ListUpdateCustomField(ctx, listID, f.Key, f.Visibility); err != nil {
+				return fmt.Errorf("updating custom field %q: %w", f.FieldName, err)
+			}
+		}
+	}
+	for _, f := range plan.Delete {
+		if err := c.ListDeleteCustomField(ctx, listID, f.Key); err != nil {
+			return fmt.Errorf("deleting custom field %q: %w", f.FieldName, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package createsend
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPlanCustomFieldSync(t *testing.T) {
+	desired := []*CustomFieldCreate{
+		{FieldName: "website", DataType: Text},
+		{FieldName: "Favorite Color", DataType: MultiSelectOne, Options: []string{"Red", "Blue", "Green"}},
+		{FieldName: "age", DataType: Number, VisibleInPreferenceCenter: true},
+	}
+	remote := []CustomFieldDefinition{
+		{FieldName: "website", Key: "[website]", DataType: Text},
+		{FieldName: "favorite color", Key: "[FavoriteColor]", DataType: MultiSelectOne, FieldOptions: []string{"Red", "Yellow"}},
+		{FieldName: "old", Key: "[old]", DataType: Date},
+	}
+
+	plan, err := PlanCustomFieldSync(desired, remote, false)
+	if err != nil {
+		t.Fatalf("PlanCustomFieldSync returned error: %v", err)
+	}
+	want := &CustomFieldPlan{
+		Create: []*CustomFieldCreate{desired[2]},
+		Update: []CustomFieldChange{{
+			Key:       "[FavoriteColor]",
+			FieldName: "favorite color",
+			Options:   &CustomFieldOptionsUpdate{KeepExistingOptions: true, Options: []string{"Blue", "Green"}},
+		}},
+	}
+	if !reflect.DeepEqual(plan, want) {
+		t.Errorf("PlanCustomFieldSync returned %+v, want %+v", plan, want)
+	}
+
+	plan, err = PlanCustomFieldSync(desired, remote, true)
+	if err != nil {
+		t.Fatalf("PlanCustomFieldSync with prune returned error: %v", err)
+	}
+	want.Update[0].Options = &CustomFieldOptionsUpdate{Options: []string{"Red", "Blue", "Green"}}
+	want.Delete = []CustomFieldDefinition{remote[2]}
+	if !reflect.DeepEqual(plan, want) {
+		t.Errorf("PlanCustomFieldSync with prune returned %+v, want %+v", plan, want)
+	}
+
+	wantDiff := "+ age (Number)\n~ favorite color [FavoriteColor] set options Red, Blue, Green\n- old [old]\n"
+	if diff := plan.String(); diff != wantDiff {
+		t.Errorf("CustomFieldPlan.String() = %q, want %q", diff, wantDiff)
+	}
+}
+
+func TestPlanCustomFieldSync_dataTypeChange(t *testing.T) {
+	desired := []*CustomFieldCreate{{FieldName: "age", DataType: Number}}
+	remote := []CustomFieldDefinition{{FieldName: "age", Key: "[age]", DataType: Text}}
+
+	if _, err := PlanCustomFieldSync(desired, remote, false); err == nil {
+		t.Error("PlanCustomFieldSync returned no error for a data type change")
+	}
+}
+
+func TestApplyCustomFieldPlan(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == "POST" || r.URL.Path == "/lists/12CD/customfields/[age].json" {
+			w.Write([]byte(`"[age]"`))
+		}
+	})
+
+	plan := &CustomFieldPlan{
+		Create: []*CustomFieldCreate{{FieldName: "website", DataType: Text}},
+		Update: []CustomFieldChange{{
+			Key:        "[age]",
+			FieldName:  "age",
+			Options:    &CustomFieldOptionsUpdate{Options: []string{"1"}},
+			Visibility: &CustomFieldUpdate{FieldName: "age", VisibleInPreferenceCenter: true},
+		}},
+		Delete: []CustomFieldDefinition{{FieldName: "old", Key: "[old]"}},
+	}
+	if err := client.ApplyCustomFieldPlan(context.Background(), "12CD", plan); err != nil {
+		t.Errorf("ApplyCustomFieldPlan returned error: %v", err)
+	}
+
+	want := []string{
+		"POST /lists/12CD/customfields.json",
+		"PUT /lists/12CD/customfields/[age]/options.json",
+		"PUT /lists/12CD/customfields/[age].json",
+		"DELETE /lists/12CD/customfields/[old].json",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("ApplyCustomFieldPlan sent %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,138 @@
+package createsend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// CreatesendError represents an error reported by the Campaign Monitor API in
+// the body of an error response.
+//
+// Errors with a given code can be matched with errors.Is:
+//
+//	if errors.Is(err, &createsend.CreatesendError{Code: 203}) {
+//		// Subscriber not in list.
+//	}
+//
+// See https://www.campaignmonitor.com/api/getting-started/#response-status-codes
+// for more information.
+type CreatesendError struct {
+	Code       int
+	Message    string
+	ResultData interface{}
+}
+
+func (e *CreatesendError) Error() string {
+	return fmt.Sprintf("%s (createsend error %d)", e.Message, e.Code)
+}
+
+// Is reports whether target is a *CreatesendError with the same Code as e.
+func (e *CreatesendError) Is(target error) bool {
+	t, ok := target.(*CreatesendError)
+	return ok && t.Code == e.Code
+}
+
+// Sentinel errors matched by *ErrorResponse values with errors.Is, according
+// to their HTTP status code.
+var (
+	ErrUnauthorized = errors.New("createsend: unauthorized")
+	ErrForbidden    = errors.New("createsend: forbidden")
+	ErrNotFound     = errors.New("createsend: not found")
+	ErrRateLimited  = errors.New("createsend: rate limited")
+	ErrServer       = errors.New("createsend: server error")
+)
+
+// ErrorResponse reports a non-2xx response from the Campaign Monitor API.
+//
+// If the response body holds a Campaign Monitor error, it is decoded into the
+// embedded CreatesendError, and errors.As can be used to extract it.
+type ErrorResponse struct {
+	CreatesendError
+
+	// Method and URL identify the request that failed.
+	Method string
+	URL    *url.URL
+
+	// StatusCode, Header and Body are those of the response.
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func (e *ErrorResponse) Error() string {
+	if e.Code != 0 {
+		return fmt.Sprintf("%s %s: %s", e.Method, e.URL, e.CreatesendError.Error())
+	}
+	return fmt.Sprintf("%s %s: http response status code %d", e.Method, e.URL, e.StatusCode)
+}
+
+// Unwrap returns the embedded CreatesendError, or nil if the response body
+// did not hold one.
+func (e *ErrorResponse) Unwrap() error {
+	if e.Code == 0 {
+		return nil
+	}
+	return &e.CreatesendError
+}
+
+// Is reports whether target is the sentinel error (such as ErrNotFound) that
+// corresponds to e's HTTP status code.
+func (e *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// IsUnauthorized reports whether err was caused by an HTTP 401 response.
+func IsUnauthorized(err error) bool { return errors.Is(err, ErrUnauthorized) }
+
+// IsForbidden reports whether err was caused by an HTTP 403 response.
+func IsForbidden(err error) bool { return errors.Is(err, ErrForbidden) }
+
+// IsNotFound reports whether err was caused by an HTTP 404 response.
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsRateLimited reports whether err was caused by an HTTP 429 response.
+func IsRateLimited(err error) bool { return errors.Is(err, ErrRateLimited) }
+
+// IsServerError reports whether err was caused by an HTTP 5xx response.
+func IsServerError(err error) bool { return errors.Is(err, ErrServer) }
+
+// newErrorResponse reads resp's body and returns it as an *ErrorResponse.
+func (c *APIClient) newErrorResponse(req *http.Request, resp *http.Response) *ErrorResponse {
+	e := &ErrorResponse{
+		Method:     req.Method,
+		URL:        req.URL,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil && c.Log != nil {
+		c.Log.Printf("ReadAll failed: %s", err)
+	}
+	e.Body = body
+	if c.Log != nil {
+		c.Log.Printf("http response %d body:\n%s", resp.StatusCode, body)
+	}
+
+	// The body is usually, but not always, a Campaign Monitor error. If it
+	// isn't, the error is still described by the status code.
+	json.Unmarshal(body, &e.CreatesendError)
+
+	return e
+}
@@ -0,0 +1,101 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDo_errorResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"Code":101,"Message":"Invalid ListID"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "lists/12CD.json", nil)
+	err := client.Do(context.Background(), req, nil)
+
+	var e *ErrorResponse
+	if !errors.As(err, &e) {
+		t.Fatalf("Do returned error %#v, want *ErrorResponse", err)
+	}
+	if e.Method != "GET" || e.URL.Path != "/lists/12CD.json" {
+		t.Errorf("ErrorResponse request = %s %s, want GET /lists/12CD.json", e.Method, e.URL)
+	}
+	if e.StatusCode != http.StatusNotFound {
+		t.Errorf("ErrorResponse StatusCode = %d, want %d", e.StatusCode, http.StatusNotFound)
+	}
+	if got := e.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("ErrorResponse Content-Type = %q, want %q", got, "application/json")
+	}
+	if want := `{"Code":101,"Message":"Invalid ListID"}`; string(e.Body) != want {
+		t.Errorf("ErrorResponse Body = %s, want %s", e.Body, want)
+	}
+
+	var cerr *CreatesendError
+	want := &CreatesendError{Code: 101, Message: "Invalid ListID"}
+	if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr, want) {
+		t.Errorf("Do returned CreatesendError %+v, want %+v", cerr, want)
+	}
+
+	if !IsNotFound(err) {
+		t.Error("IsNotFound returned false, want true")
+	}
+	if IsUnauthorized(err) || IsForbidden(err) || IsRateLimited(err) || IsServerError(err) {
+		t.Error("Do returned error matching an unrelated status")
+	}
+}
+
+func TestDo_errorResponseNotJSON(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	err := client.Do(context.Background(), req, nil)
+
+	var e *ErrorResponse
+	if !errors.As(err, &e) {
+		t.Fatalf("Do returned error %#v, want *ErrorResponse", err)
+	}
+	if e.Unwrap() != nil {
+		t.Errorf("ErrorResponse Unwrap = %v, want nil", e.Unwrap())
+	}
+	if !IsServerError(err) {
+		t.Error("IsServerError returned false, want true")
+	}
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	tests := []struct {
+		status int
+		target error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusInternalServerError, ErrServer},
+		{http.StatusBadGateway, ErrServer},
+	}
+	for _, tt := range tests {
+		err := fmt.Errorf("wrapped: %w", &ErrorResponse{StatusCode: tt.status})
+		if !errors.Is(err, tt.target) {
+			t.Errorf("errors.Is(%d, %v) = false, want true", tt.status, tt.target)
+		}
+	}
+
+	if errors.Is(&ErrorResponse{StatusCode: http.StatusBadRequest}, ErrServer) {
+		t.Error("errors.Is(400, ErrServer) = true, want false")
+	}
+}
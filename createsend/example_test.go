@@ -1,6 +1,7 @@
 package createsend_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -20,7 +21,7 @@ func Example() {
 	}
 
 	c := createsend.NewAPIClient(authClient)
-	clients, err := c.ListClients()
+	clients, err := c.ListClients(context.Background())
 	if err != nil {
 		fmt.Printf("Error listing clients: %s\n", err)
 		os.Exit(1)
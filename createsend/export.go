@@ -0,0 +1,96 @@
+package createsend
+
+import "context"
+
+// ExportSubscribers calls fn for each subscriber in a given group of a list,
+// in the same order as walking the pages of ListSubscribers one by one.
+//
+// After the first page has been fetched and the number of pages is known, the
+// remaining pages are fetched concurrently by up to workers requests at a
+// time. At most workers pages are held in memory while waiting for fn to
+// consume earlier pages. Each request goes through Do, so the client's Retry
+// policy and BlockOnRateLimit setting apply to it; when sharing a rate limit
+// with other callers, set BlockOnRateLimit rather than raising workers.
+//
+// Iteration starts at opt.Page (or the first page) and stops at the first
+// error returned by the API, by fn, or by ctx.
+func (c *APIClient) ExportSubscribers(ctx context.Context, listID string, group SubscriberGroup, opt *ListSubscribersOptions, workers int, fn func(*Subscriber) error) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	var o ListSubscribersOptions
+	if opt != nil {
+		o = *opt
+	}
+	if o.Page < 1 {
+		o.Page = 1
+	}
+
+	first, err := c.ListSubscribers(ctx, listID, group, &o)
+	if err != nil {
+		return err
+	}
+	for _, sub := range first.Results {
+		if err := fn(sub); err != nil {
+			return err
+		}
+	}
+	if len(first.Results) == 0 || first.PageNumber >= first.NumberOfPages {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type page struct {
+		res *ListSubscribersResponse
+		err error
+	}
+
+	// pages[i] receives page first.PageNumber+1+i. Each channel is buffered so
+	// that fetches never block, even if we stop consuming early.
+	pages := make([]chan page, first.NumberOfPages-first.PageNumber)
+	for i := range pages {
+		pages[i] = make(chan page, 1)
+	}
+
+	// sem holds a token for each page that has been requested but not yet
+	// consumed.
+	sem := make(chan struct{}, workers)
+	go func() {
+		for i := range pages {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(i int) {
+				o := o
+				o.Page = first.PageNumber + 1 + i
+				res, err := c.ListSubscribers(ctx, listID, group, &o)
+				pages[i] <- page{res, err}
+			}(i)
+		}
+	}()
+
+	for _, ch := range pages {
+		var p page
+		select {
+		case p = <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		<-sem
+
+		if p.err != nil {
+			return p.err
+		}
+		for _, sub := range p.res.Results {
+			if err := fn(sub); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExportSubscribers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const numPages, workers = 6, 3
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	mux.HandleFunc("/lists/12CD/active.json", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		// Make later pages respond faster, so they complete out of order.
+		time.Sleep(time.Duration(numPages-page) * 2 * time.Millisecond)
+		fmt.Fprintf(w, `{"Results":[{"EmailAddress":"%d-a@example.com"},{"EmailAddress":"%d-b@example.com"}],"PageNumber":%d,"NumberOfPages":%d}`, page, page, page, numPages)
+	})
+
+	var got []string
+	err := client.ExportSubscribers(context.Background(), "12CD", ActiveSubscribers, nil, workers, func(sub *Subscriber) error {
+		got = append(got, sub.EmailAddress)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("ExportSubscribers returned error: %v", err)
+	}
+
+	if len(got) != 2*numPages {
+		t.Fatalf("ExportSubscribers returned %d subscribers, want %d", len(got), 2*numPages)
+	}
+	for i, email := range got {
+		if want := fmt.Sprintf("%d-%c@example.com", i/2+1, 'a'+i%2); email != want {
+			t.Errorf("subscriber %d = %s, want %s", i, email, want)
+		}
+	}
+	if maxInFlight > workers {
+		t.Errorf("ExportSubscribers sent %d concurrent requests, want at most %d", maxInFlight, workers)
+	}
+}
+
+func TestExportSubscribers_callbackError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/active.json", func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		fmt.Fprintf(w, `{"Results":[{"EmailAddress":"%s@example.com"}],"PageNumber":%s,"NumberOfPages":50}`, page, page)
+	})
+
+	stop := errors.New("stop")
+	var n int
+	err := client.ExportSubscribers(context.Background(), "12CD", ActiveSubscribers, nil, 4, func(sub *Subscriber) error {
+		n++
+		if n == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("ExportSubscribers returned error %v, want %v", err, stop)
+	}
+	if n != 3 {
+		t.Errorf("ExportSubscribers called fn %d times, want %d", n, 3)
+	}
+}
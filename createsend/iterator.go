@@ -0,0 +1,293 @@
+package createsend
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// SubscriberIterator iterates over the subscribers returned by ListSubscribers,
+// fetching each page from the API as it is needed.
+//
+//	it := c.ListSubscribersIterator(ctx, listID, ActiveSubscribers, &ListSubscribersOptions{PageSize: 1000})
+//	for it.Next() {
+//		sub := it.Subscriber()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type SubscriberIterator struct {
+	c      *APIClient
+	ctx    context.Context
+	listID string
+	group  SubscriberGroup
+	opt    ListSubscribersOptions
+
+	page []*Subscriber
+	cur  *Subscriber
+	done bool
+	err  error
+}
+
+// ListSubscribersIterator returns an iterator over all of the subscribers in a
+// given group of a list. Iteration starts at opt.Page (or the first page) and
+// fetches opt.PageSize subscribers per request. It stops early if ctx is
+// canceled.
+func (c *APIClient) ListSubscribersIterator(ctx context.Context, listID string, group SubscriberGroup, opt *ListSubscribersOptions) *SubscriberIterator {
+	it := &SubscriberIterator{c: c, ctx: ctx, listID: listID, group: group}
+	if opt != nil {
+		it.opt = *opt
+	}
+	if it.opt.Page < 1 {
+		it.opt.Page = 1
+	}
+	return it
+}
+
+// Next advances the iterator to the next subscriber, which is then available
+// through Subscriber. It returns false when there are no more subscribers or an
+// error occurred.
+func (it *SubscriberIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		if it.err = it.ctx.Err(); it.err != nil {
+			return false
+		}
+
+		res, err := it.c.ListSubscribers(it.ctx, it.listID, it.group, &it.opt)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = res.Results
+		it.done = len(res.Results) == 0 || res.PageNumber >= res.NumberOfPages
+		it.opt.Page++
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Subscriber returns the current subscriber.
+func (it *SubscriberIterator) Subscriber() *Subscriber {
+	return it.cur
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *SubscriberIterator) Err() error {
+	return it.err
+}
+
+// RecipientIterator iterates over the recipients returned by
+// CampaignRecipients, fetching each page from the API as it is needed. It is
+// used like SubscriberIterator.
+type RecipientIterator struct {
+	c          *APIClient
+	ctx        context.Context
+	campaignID string
+	opt        CampaignRecipientsOptions
+
+	page []*Recipient
+	cur  *Recipient
+	done bool
+	err  error
+}
+
+// CampaignRecipientsIterator returns an iterator over all of the recipients of
+// a campaign. Iteration starts at opt.Page (or the first page) and fetches
+// opt.PageSize recipients per request. It stops early if ctx is canceled.
+func (c *APIClient) CampaignRecipientsIterator(ctx context.Context, campaignID string, opt *CampaignRecipientsOptions) *RecipientIterator {
+	it := &RecipientIterator{c: c, ctx: ctx, campaignID: campaignID}
+	if opt != nil {
+		it.opt = *opt
+	}
+	if it.opt.Page < 1 {
+		it.opt.Page = 1
+	}
+	return it
+}
+
+// Next advances the iterator to the next recipient, which is then available
+// through Recipient. It returns false when there are no more recipients or an
+// error occurred.
+func (it *RecipientIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		if it.err = it.ctx.Err(); it.err != nil {
+			return false
+		}
+
+		res, err := it.c.CampaignRecipients(it.ctx, it.campaignID, &it.opt)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page = res.Results
+		it.done = len(res.Results) == 0 || res.PageNumber >= res.NumberOfPages
+		it.opt.Page++
+	}
+
+	it.cur, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Recipient returns the current recipient.
+func (it *RecipientIterator) Recipient() *Recipient {
+	return it.cur
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *RecipientIterator) Err() error {
+	return it.err
+}
+
+// TimelineDirection is the direction in which a MessageIterator walks the
+// transactional message timeline.
+type TimelineDirection int
+
+const (
+	// Backward walks from newer to older messages, using sentBeforeID.
+	Backward TimelineDirection = iota
+
+	// Forward walks from older to newer messages, using sentAfterID.
+	Forward
+)
+
+// maxMessageTimelineCount is the largest number of messages the API returns
+// per timeline request.
+const maxMessageTimelineCount = 200
+
+// MessageIteratorOptions configures a MessageIterator.
+type MessageIteratorOptions struct {
+	// MessageTimelineOptions filters the messages. Its SentBeforeID and
+	// SentAfterID fields are managed by the iterator and ignored.
+	MessageTimelineOptions
+
+	Direction TimelineDirection
+
+	// StartID is the ID of the message to start after, exclusive, such as
+	// the Cursor saved from a previous iteration. It is required when
+	// walking Forward. When walking Backward and it is empty, iteration
+	// starts at the newest message.
+	StartID string
+
+	// Until, if set, stops the iteration at the first message sent before it
+	// (when walking Backward) or after it (when walking Forward).
+	Until time.Time
+}
+
+// MessageIterator iterates over the transactional message timeline, fetching
+// pages from the API as needed and following the sentBeforeID/sentAfterID
+// cursors. It is used like SubscriberIterator.
+type MessageIterator struct {
+	c     *APIClient
+	ctx   context.Context
+	opt   MessageIteratorOptions
+	count int
+
+	page   []*Message
+	cur    *Message
+	cursor string
+	done   bool
+	err    error
+}
+
+// MessageTimelineIterator returns an iterator over the transactional message
+// timeline. It stops early if ctx is canceled.
+func (c *APIClient) MessageTimelineIterator(ctx context.Context, opt *MessageIteratorOptions) *MessageIterator {
+	it := &MessageIterator{c: c, ctx: ctx}
+	if opt != nil {
+		it.opt = *opt
+	}
+	it.cursor = it.opt.StartID
+	it.count = it.opt.Count
+	if it.count <= 0 {
+		it.count = 50
+	} else if it.count > maxMessageTimelineCount {
+		// A larger count would be capped by the API, and the short pages
+		// mistaken for the end of the timeline.
+		it.count = maxMessageTimelineCount
+	}
+	if it.opt.Direction == Forward && it.cursor == "" {
+		it.err = errors.New("MessageTimelineIterator: StartID is required to walk Forward")
+	}
+	return it
+}
+
+// Next advances the iterator to the next message, which is then available
+// through Message. It returns false when there are no more messages, the
+// Until bound has been reached or an error occurred.
+func (it *MessageIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		if it.err = it.ctx.Err(); it.err != nil {
+			return false
+		}
+
+		opt := it.opt.MessageTimelineOptions
+		opt.Count = it.count
+		opt.SentBeforeID, opt.SentAfterID = "", ""
+		if it.opt.Direction == Forward {
+			opt.SentAfterID = it.cursor
+		} else {
+			opt.SentBeforeID = it.cursor
+		}
+
+		messages, err := it.c.MessageTimeline(it.ctx, &opt)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.done = len(messages) < it.count
+
+		// The API returns messages newest first.
+		if it.opt.Direction == Forward {
+			for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+				messages[i], messages[j] = messages[j], messages[i]
+			}
+		}
+		it.page = messages
+	}
+
+	m := it.page[0]
+	if !it.opt.Until.IsZero() {
+		sentAt, err := time.Parse(time.RFC3339, m.SentAt)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if it.opt.Direction == Forward && sentAt.After(it.opt.Until) ||
+			it.opt.Direction == Backward && sentAt.Before(it.opt.Until) {
+			it.page, it.done = nil, true
+			return false
+		}
+	}
+
+	it.cur, it.page = m, it.page[1:]
+	it.cursor = m.MessageID
+	return true
+}
+
+// Message returns the current message.
+func (it *MessageIterator) Message() *Message {
+	return it.cur
+}
+
+// Cursor returns the ID of the last message returned by the iterator (or
+// StartID if none has been). Saving it and passing it as StartID later
+// resumes the iteration where it left off.
+func (it *MessageIterator) Cursor() string {
+	return it.cursor
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *MessageIterator) Err() error {
+	return it.err
+}
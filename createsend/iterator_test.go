@@ -0,0 +1,227 @@
+package createsend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListSubscribersIterator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/active.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		page := r.URL.Query().Get("page")
+		if ps := r.URL.Query().Get("pagesize"); ps != "2" {
+			t.Errorf("pagesize = %s, want 2", ps)
+		}
+		switch page {
+		case "1":
+			fmt.Fprint(w, `{"Results":[{"EmailAddress":"a@example.com"},{"EmailAddress":"b@example.com"}],"PageNumber":1,"NumberOfPages":2}`)
+		case "2":
+			fmt.Fprint(w, `{"Results":[{"EmailAddress":"c@example.com"}],"PageNumber":2,"NumberOfPages":2}`)
+		default:
+			t.Errorf("Unexpected request for page %s", page)
+		}
+	})
+
+	it := client.ListSubscribersIterator(context.Background(), "12CD", ActiveSubscribers, &ListSubscribersOptions{PageSize: 2})
+	var emails []string
+	for it.Next() {
+		emails = append(emails, it.Subscriber().EmailAddress)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("SubscriberIterator returned error: %v", err)
+	}
+
+	want := []string{"a@example.com", "b@example.com", "c@example.com"}
+	if !reflect.DeepEqual(emails, want) {
+		t.Errorf("SubscriberIterator returned %v, want %v", emails, want)
+	}
+}
+
+func TestListSubscribersIterator_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/active.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Results":[{"EmailAddress":"a@example.com"}],"PageNumber":1,"NumberOfPages":100}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := client.ListSubscribersIterator(ctx, "12CD", ActiveSubscribers, nil)
+	if !it.Next() {
+		t.Fatalf("SubscriberIterator returned no subscribers: %v", it.Err())
+	}
+	cancel()
+	if it.Next() {
+		t.Error("SubscriberIterator continued after cancellation")
+	}
+	if it.Err() != context.Canceled {
+		t.Errorf("SubscriberIterator returned error %v, want %v", it.Err(), context.Canceled)
+	}
+}
+
+func TestCampaignRecipientsIterator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/campaigns/13CD/recipients.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.URL.Query().Get("page"); page {
+		case "3":
+			fmt.Fprint(w, `{"Results":[{"EmailAddress":"a@example.com","ListID":"12CD"}],"PageNumber":3,"NumberOfPages":4}`)
+		case "4":
+			fmt.Fprint(w, `{"Results":[{"EmailAddress":"b@example.com","ListID":"12CD"}],"PageNumber":4,"NumberOfPages":4}`)
+		default:
+			t.Errorf("Unexpected request for page %s", page)
+		}
+	})
+
+	it := client.CampaignRecipientsIterator(context.Background(), "13CD", &CampaignRecipientsOptions{Page: 3})
+	var recipients []*Recipient
+	for it.Next() {
+		recipients = append(recipients, it.Recipient())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("RecipientIterator returned error: %v", err)
+	}
+
+	want := []*Recipient{{EmailAddress: "a@example.com", ListID: "12CD"}, {EmailAddress: "b@example.com", ListID: "12CD"}}
+	if !reflect.DeepEqual(recipients, want) {
+		t.Errorf("RecipientIterator returned %+v, want %+v", recipients, want)
+	}
+}
+
+func TestMessageTimelineIterator_backward(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		q := r.URL.Query()
+		if q.Get("count") != "2" || q.Get("group") != "Receipts" || q.Get("sentAfterID") != "" {
+			t.Errorf("Unexpected querystring %s", r.URL.RawQuery)
+		}
+		switch q.Get("sentBeforeID") {
+		case "":
+			fmt.Fprint(w, `[{"MessageID":"m5","SentAt":"2014-01-05T00:00:00Z"},{"MessageID":"m4","SentAt":"2014-01-04T00:00:00Z"}]`)
+		case "m4":
+			fmt.Fprint(w, `[{"MessageID":"m3","SentAt":"2014-01-03T00:00:00Z"},{"MessageID":"m2","SentAt":"2014-01-02T00:00:00Z"}]`)
+		default:
+			t.Errorf("Unexpected sentBeforeID %s", q.Get("sentBeforeID"))
+		}
+	})
+
+	it := client.MessageTimelineIterator(context.Background(), &MessageIteratorOptions{
+		MessageTimelineOptions: MessageTimelineOptions{Count: 2, Group: "Receipts"},
+		Until:                  time.Date(2014, 1, 3, 0, 0, 0, 0, time.UTC),
+	})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Message().MessageID)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("MessageIterator returned error: %v", err)
+	}
+
+	if want := []string{"m5", "m4", "m3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("MessageIterator returned %v, want %v", ids, want)
+	}
+	if it.Cursor() != "m3" {
+		t.Errorf("MessageIterator Cursor = %q, want %q", it.Cursor(), "m3")
+	}
+}
+
+func TestMessageTimelineIterator_forward(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("sentAfterID") {
+		case "m1":
+			fmt.Fprint(w, `[{"MessageID":"m3","SentAt":"2014-01-03T00:00:00Z"},{"MessageID":"m2","SentAt":"2014-01-02T00:00:00Z"}]`)
+		case "m3":
+			fmt.Fprint(w, `[{"MessageID":"m4","SentAt":"2014-01-04T00:00:00Z"}]`)
+		default:
+			t.Errorf("Unexpected sentAfterID %s", q.Get("sentAfterID"))
+		}
+	})
+
+	it := client.MessageTimelineIterator(context.Background(), &MessageIteratorOptions{
+		MessageTimelineOptions: MessageTimelineOptions{Count: 2},
+		Direction:              Forward,
+		StartID:                "m1",
+	})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Message().MessageID)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("MessageIterator returned error: %v", err)
+	}
+
+	if want := []string{"m2", "m3", "m4"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("MessageIterator returned %v, want %v", ids, want)
+	}
+	if it.Cursor() != "m4" {
+		t.Errorf("MessageIterator Cursor = %q, want %q", it.Cursor(), "m4")
+	}
+}
+
+func TestMessageTimelineIterator_countAboveLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("count") != "200" {
+			t.Errorf("count = %s, want 200", q.Get("count"))
+		}
+
+		// The API returns at most 200 messages per request: a full first
+		// page, then a last message.
+		var messages []*Message
+		switch q.Get("sentBeforeID") {
+		case "":
+			for i := 0; i < 200; i++ {
+				messages = append(messages, &Message{MessageID: fmt.Sprintf("m%d", i)})
+			}
+		case "m199":
+			messages = append(messages, &Message{MessageID: "m200"})
+		default:
+			t.Errorf("Unexpected sentBeforeID %s", q.Get("sentBeforeID"))
+		}
+		json.NewEncoder(w).Encode(messages)
+	})
+
+	it := client.MessageTimelineIterator(context.Background(), &MessageIteratorOptions{
+		MessageTimelineOptions: MessageTimelineOptions{Count: 500},
+	})
+	var n int
+	for it.Next() {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("MessageIterator returned error: %v", err)
+	}
+	if n != 201 {
+		t.Errorf("MessageIterator returned %d messages, want %d", n, 201)
+	}
+}
+
+func TestMessageTimelineIterator_forwardWithoutStart(t *testing.T) {
+	it := NewAPIClient(nil).MessageTimelineIterator(context.Background(), &MessageIteratorOptions{Direction: Forward})
+	if it.Next() {
+		t.Error("MessageIterator returned a message without StartID")
+	}
+	if it.Err() == nil {
+		t.Error("MessageIterator returned no error without StartID")
+	}
+}
@@ -0,0 +1,297 @@
+package createsend
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Journey represents a journey (automated email sequence) of a client.
+//
+// See https://www.campaignmonitor.com/api/journeys/ for more information.
+type Journey struct {
+	JourneyID   string `json:"JourneyID"`
+	Name        string `json:"Name"`
+	TriggerType string `json:"TriggerType"`
+	Status      string `json:"Status"`
+}
+
+// Journeys lists the journeys of a client.
+//
+// See https://www.campaignmonitor.com/api/clients/#listing-journeys for more
+// information.
+func (c *APIClient) Journeys(ctx context.Context, clientID string) ([]*Journey, error) {
+	u := fmt.Sprintf("clients/%s/journeys.json", clientID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var journeys []*Journey
+	err = c.Do(ctx, req, &journeys)
+	return journeys, err
+}
+
+// JourneySummary represents a journey and the emails it sends.
+//
+// See https://www.campaignmonitor.com/api/journeys/#journey-summary for more
+// information.
+type JourneySummary struct {
+	JourneyID   string          `json:"JourneyID"`
+	Name        string          `json:"Name"`
+	TriggerType string          `json:"TriggerType"`
+	Status      string          `json:"Status"`
+	Emails      []*JourneyEmail `json:"Emails"`
+}
+
+// JourneyEmail represents an email in a journey and its statistics.
+type JourneyEmail struct {
+	EmailID      string `json:"EmailID"`
+	Name         string `json:"Name"`
+	Bounced      int    `json:"Bounced"`
+	Clicked      int    `json:"Clicked"`
+	Opened       int    `json:"Opened"`
+	Sent         int    `json:"Sent"`
+	UniqueOpened int    `json:"UniqueOpened"`
+	Unsubscribed int    `json:"Unsubscribed"`
+}
+
+// JourneySummary gets a summary of a journey, including its emails.
+//
+// See https://www.campaignmonitor.com/api/journeys/#journey-summary for more
+// information.
+func (c *APIClient) JourneySummary(ctx context.Context, journeyID string) (*JourneySummary, error) {
+	u := fmt.Sprintf("journeys/%s.json", journeyID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary JourneySummary
+	err = c.Do(ctx, req, &summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// JourneyEmailOptions represents the URL parameters that may be used to
+// filter and page through the recipients and activity of a journey email. If
+// Date is set, only records on or after it (in the client's time zone, to the
+// minute) are returned.
+//
+// See https://www.campaignmonitor.com/api/journeys/#journey-email-recipients
+// for more information.
+type JourneyEmailOptions struct {
+	Date           time.Time
+	Page           int
+	PageSize       int
+	OrderDirection string
+}
+
+// JourneyRecipient represents a recipient of a journey email.
+type JourneyRecipient struct {
+	EmailAddress string `json:"EmailAddress"`
+	SentDate     string `json:"SentDate"`
+}
+
+type JourneyRecipientsResponse struct {
+	Results              []*JourneyRecipient `json:"Results"`
+	ResultsOrderedBy     string              `json:"ResultsOrderedBy"`
+	OrderDirection       string              `json:"OrderDirection"`
+	PageNumber           int                 `json:"PageNumber"`
+	PageSize             int                 `json:"PageSize"`
+	RecordsOnThisPage    int                 `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int                 `json:"TotalNumberOfRecords"`
+	NumberOfPages        int                 `json:"NumberOfPages"`
+}
+
+// JourneyEmailRecipients lists the recipients of a journey email.
+//
+// See https://www.campaignmonitor.com/api/journeys/#journey-email-recipients
+// for more information.
+func (c *APIClient) JourneyEmailRecipients(ctx context.Context, emailID string, opt *JourneyEmailOptions) (*JourneyRecipientsResponse, error) {
+	var results JourneyRecipientsResponse
+	err := c.journeyEmailActivity(ctx, emailID, "recipients", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// JourneyOpen represents a recipient opening a journey email.
+type JourneyOpen struct {
+	EmailAddress string  `json:"EmailAddress"`
+	Date         string  `json:"Date"`
+	IPAddress    string  `json:"IPAddress"`
+	Latitude     float64 `json:"Latitude"`
+	Longitude    float64 `json:"Longitude"`
+	City         string  `json:"City"`
+	Region       string  `json:"Region"`
+	CountryCode  string  `json:"CountryCode"`
+	CountryName  string  `json:"CountryName"`
+}
+
+type JourneyOpensResponse struct {
+	Results              []*JourneyOpen `json:"Results"`
+	ResultsOrderedBy     string         `json:"ResultsOrderedBy"`
+	OrderDirection       string         `json:"OrderDirection"`
+	PageNumber           int            `json:"PageNumber"`
+	PageSize             int            `json:"PageSize"`
+	RecordsOnThisPage    int            `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int            `json:"TotalNumberOfRecords"`
+	NumberOfPages        int            `json:"NumberOfPages"`
+}
+
+// JourneyEmailOpens lists the opens of a journey email.
+//
+// See https://www.campaignmonitor.com/api/journeys/#journey-email-opens for
+// more information.
+func (c *APIClient) JourneyEmailOpens(ctx context.Context, emailID string, opt *JourneyEmailOptions) (*JourneyOpensResponse, error) {
+	var results JourneyOpensResponse
+	err := c.journeyEmailActivity(ctx, emailID, "opens", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// JourneyClick represents a recipient clicking a link in a journey email.
+type JourneyClick struct {
+	EmailAddress string  `json:"EmailAddress"`
+	Date         string  `json:"Date"`
+	URL          string  `json:"URL"`
+	IPAddress    string  `json:"IPAddress"`
+	Latitude     float64 `json:"Latitude"`
+	Longitude    float64 `json:"Longitude"`
+	City         string  `json:"City"`
+	Region       string  `json:"Region"`
+	CountryCode  string  `json:"CountryCode"`
+	CountryName  string  `json:"CountryName"`
+}
+
+type JourneyClicksResponse struct {
+	Results              []*JourneyClick `json:"Results"`
+	ResultsOrderedBy     string          `json:"ResultsOrderedBy"`
+	OrderDirection       string          `json:"OrderDirection"`
+	PageNumber           int             `json:"PageNumber"`
+	PageSize             int             `json:"PageSize"`
+	RecordsOnThisPage    int             `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int             `json:"TotalNumberOfRecords"`
+	NumberOfPages        int             `json:"NumberOfPages"`
+}
+
+// JourneyEmailClicks lists the link clicks of a journey email.
+//
+// See https://www.campaignmonitor.com/api/journeys/#journey-email-clicks for
+// more information.
+func (c *APIClient) JourneyEmailClicks(ctx context.Context, emailID string, opt *JourneyEmailOptions) (*JourneyClicksResponse, error) {
+	var results JourneyClicksResponse
+	err := c.journeyEmailActivity(ctx, emailID, "clicks", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// JourneyBounce represents a journey email that bounced.
+type JourneyBounce struct {
+	EmailAddress string `json:"EmailAddress"`
+	BounceType   string `json:"BounceType"`
+	Date         string `json:"Date"`
+	Reason       string `json:"Reason"`
+}
+
+type JourneyBouncesResponse struct {
+	Results              []*JourneyBounce `json:"Results"`
+	ResultsOrderedBy     string           `json:"ResultsOrderedBy"`
+	OrderDirection       string           `json:"OrderDirection"`
+	PageNumber           int              `json:"PageNumber"`
+	PageSize             int              `json:"PageSize"`
+	RecordsOnThisPage    int              `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int              `json:"TotalNumberOfRecords"`
+	NumberOfPages        int              `json:"NumberOfPages"`
+}
+
+// JourneyEmailBounces lists the bounces of a journey email.
+//
+// See https://www.campaignmonitor.com/api/journeys/#journey-email-bounces for
+// more information.
+func (c *APIClient) JourneyEmailBounces(ctx context.Context, emailID string, opt *JourneyEmailOptions) (*JourneyBouncesResponse, error) {
+	var results JourneyBouncesResponse
+	err := c.journeyEmailActivity(ctx, emailID, "bounces", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// JourneyUnsubscribe represents a recipient unsubscribing from a journey
+// email.
+type JourneyUnsubscribe struct {
+	EmailAddress string `json:"EmailAddress"`
+	Date         string `json:"Date"`
+	IPAddress    string `json:"IPAddress"`
+}
+
+type JourneyUnsubscribesResponse struct {
+	Results              []*JourneyUnsubscribe `json:"Results"`
+	ResultsOrderedBy     string                `json:"ResultsOrderedBy"`
+	OrderDirection       string                `json:"OrderDirection"`
+	PageNumber           int                   `json:"PageNumber"`
+	PageSize             int                   `json:"PageSize"`
+	RecordsOnThisPage    int                   `json:"RecordsOnThisPage"`
+	TotalNumberOfRecords int                   `json:"TotalNumberOfRecords"`
+	NumberOfPages        int                   `json:"NumberOfPages"`
+}
+
+// JourneyEmailUnsubscribes lists the unsubscribes caused by a journey email.
+//
+// See https://www.campaignmonitor.com/api/journeys/#journey-email-unsubscribes
+// for more information.
+func (c *APIClient) JourneyEmailUnsubscribes(ctx context.Context, emailID string, opt *JourneyEmailOptions) (*JourneyUnsubscribesResponse, error) {
+	var results JourneyUnsubscribesResponse
+	err := c.journeyEmailActivity(ctx, emailID, "unsubscribes", opt, &results)
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// journeyEmailActivity fetches a page of the given kind of journey email
+// records ("recipients", "opens", etc.) into results.
+func (c *APIClient) journeyEmailActivity(ctx context.Context, emailID string, kind string, opt *JourneyEmailOptions, results interface{}) error {
+	u := fmt.Sprintf("journeys/email/%s/%s.json", emailID, kind)
+
+	if opt != nil {
+		v := url.Values{}
+		if !opt.Date.IsZero() {
+			v.Set("date", opt.Date.Format("2006-01-02 15:04"))
+		}
+		if opt.Page > 0 {
+			v.Set("page", strconv.Itoa(opt.Page))
+		}
+		if opt.PageSize > 0 {
+			v.Set("pagesize", strconv.Itoa(opt.PageSize))
+		}
+		if opt.OrderDirection != "" {
+			v.Set("orderdirection", opt.OrderDirection)
+		}
+
+		q := v.Encode()
+		if q != "" {
+			u = fmt.Sprintf("%s?%s", u, q)
+		}
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, results)
+}
@@ -0,0 +1,148 @@
+package createsend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJourneys(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/clients/12CD/journeys.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"JourneyID":"j1","Name":"Onboarding","TriggerType":"On Subscription","Status":"Active"}]`)
+	})
+
+	journeys, err := client.Journeys(context.Background(), "12CD")
+	if err != nil {
+		t.Errorf("Journeys returned error: %v", err)
+	}
+
+	want := []*Journey{{JourneyID: "j1", Name: "Onboarding", TriggerType: "On Subscription", Status: "Active"}}
+	if !reflect.DeepEqual(journeys, want) {
+		t.Errorf("Journeys returned %+v, want %+v", journeys, want)
+	}
+}
+
+func TestJourneySummary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/journeys/j1.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"JourneyID": "j1",
+			"Name": "Onboarding",
+			"TriggerType": "On Subscription",
+			"Status": "Active",
+			"Emails": [
+				{
+					"EmailID": "e1",
+					"Name": "Welcome",
+					"Bounced": 1,
+					"Clicked": 2,
+					"Opened": 10,
+					"Sent": 20,
+					"UniqueOpened": 8,
+					"Unsubscribed": 0
+				}
+			]
+		}`)
+	})
+
+	summary, err := client.JourneySummary(context.Background(), "j1")
+	if err != nil {
+		t.Errorf("JourneySummary returned error: %v", err)
+	}
+
+	want := &JourneySummary{
+		JourneyID:   "j1",
+		Name:        "Onboarding",
+		TriggerType: "On Subscription",
+		Status:      "Active",
+		Emails: []*JourneyEmail{
+			{EmailID: "e1", Name: "Welcome", Bounced: 1, Clicked: 2, Opened: 10, Sent: 20, UniqueOpened: 8},
+		},
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("JourneySummary returned %+v, want %+v", summary, want)
+	}
+}
+
+func TestJourneyEmailRecipients(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/journeys/email/e1/recipients.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "date=2019-01-01+00%3A00&orderdirection=desc&page=2&pagesize=10")
+		fmt.Fprint(w, `{
+			"Results": [
+				{"EmailAddress": "a@example.com", "SentDate": "2019-01-02 09:00:00"}
+			],
+			"ResultsOrderedBy": "SentDate",
+			"OrderDirection": "desc",
+			"PageNumber": 2,
+			"PageSize": 10,
+			"RecordsOnThisPage": 1,
+			"TotalNumberOfRecords": 11,
+			"NumberOfPages": 2
+		}`)
+	})
+
+	opt := &JourneyEmailOptions{
+		Date:           time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Page:           2,
+		PageSize:       10,
+		OrderDirection: "desc",
+	}
+	recipients, err := client.JourneyEmailRecipients(context.Background(), "e1", opt)
+	if err != nil {
+		t.Errorf("JourneyEmailRecipients returned error: %v", err)
+	}
+
+	want := &JourneyRecipientsResponse{
+		Results:              []*JourneyRecipient{{EmailAddress: "a@example.com", SentDate: "2019-01-02 09:00:00"}},
+		ResultsOrderedBy:     "SentDate",
+		OrderDirection:       "desc",
+		PageNumber:           2,
+		PageSize:             10,
+		RecordsOnThisPage:    1,
+		TotalNumberOfRecords: 11,
+		NumberOfPages:        2,
+	}
+	if !reflect.DeepEqual(recipients, want) {
+		t.Errorf("JourneyEmailRecipients returned %+v, want %+v", recipients, want)
+	}
+}
+
+func TestJourneyEmailBounces(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/journeys/email/e1/bounces.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"Results": [
+				{"EmailAddress": "a@example.com", "BounceType": "Hard", "Date": "2019-01-02 09:00:00", "Reason": "Hard Bounce"}
+			],
+			"PageNumber": 1,
+			"NumberOfPages": 1
+		}`)
+	})
+
+	bounces, err := client.JourneyEmailBounces(context.Background(), "e1", nil)
+	if err != nil {
+		t.Errorf("JourneyEmailBounces returned error: %v", err)
+	}
+
+	want := []*JourneyBounce{{EmailAddress: "a@example.com", BounceType: "Hard", Date: "2019-01-02 09:00:00", Reason: "Hard Bounce"}}
+	if !reflect.DeepEqual(bounces.Results, want) {
+		t.Errorf("JourneyEmailBounces returned %+v, want %+v", bounces.Results, want)
+	}
+}
@@ -1,6 +1,7 @@
 package createsend
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -56,7 +57,7 @@ type ListSubscribersResponse struct {
 //
 // See http://www.campaignmonitor.com/api/lists/#active_subscribers for more
 // information.
-func (c *APIClient) ListSubscribers(listID string, group SubscriberGroup, opt *ListSubscribersOptions) (*ListSubscribersResponse, error) {
+func (c *APIClient) ListSubscribers(ctx context.Context, listID string, group SubscriberGroup, opt *ListSubscribersOptions) (*ListSubscribersResponse, error) {
 	u := fmt.Sprintf("lists/%s/%s.json", listID, group)
 
 	if opt != nil {
@@ -89,7 +90,7 @@ func (c *APIClient) ListSubscribers(listID string, group SubscriberGroup, opt *L
 	}
 
 	var results ListSubscribersResponse
-	err = c.Do(req, &results)
+	err = c.Do(ctx, req, &results)
 	return &results, err
 }
 
@@ -97,7 +98,7 @@ func (c *APIClient) ListSubscribers(listID string, group SubscriberGroup, opt *L
 //
 // See https://www.campaignmonitor.com/api/lists/#deleting_a_list for more
 // information.
-func (c *APIClient) ListDelete(listID string) error {
+func (c *APIClient) ListDelete(ctx context.Context, listID string) error {
 	u := fmt.Sprintf("lists/%s.json", listID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -105,7 +106,7 @@ func (c *APIClient) ListDelete(listID string) error {
 		return err
 	}
 
-	err = c.Do(req, nil)
+	err = c.Do(ctx, req, nil)
 	return err
 }
 
@@ -133,7 +134,7 @@ type ListCreateOptions struct {
 //
 // See https://www.campaignmonitor.com/api/lists/#creating_a_list for more
 // information.
-func (c *APIClient) ListCreate(clientID string, opt *ListCreateOptions) (string, error) {
+func (c *APIClient) ListCreate(ctx context.Context, clientID string, opt *ListCreateOptions) (string, error) {
 	if opt.UnsubscribeSetting == "" {
 		return "", errors.New("Unsubscribesetting not set")
 	}
@@ -146,7 +147,7 @@ func (c *APIClient) ListCreate(clientID string, opt *ListCreateOptions) (string,
 	}
 
 	var v interface{}
-	err = c.Do(req, &v)
+	err = c.Do(ctx, req, &v)
 	if err != nil {
 		return "", err
 	}
@@ -159,6 +160,130 @@ func (c *APIClient) ListCreate(clientID string, opt *ListCreateOptions) (string,
 	return s, nil
 }
 
+// ListDetails represents the settings of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#list_details for more
+// information.
+type ListDetails struct {
+	ListID                  string            `json:"ListID"`
+	Title                   string            `json:"Title"`
+	ConfirmedOptIn          bool              `json:"ConfirmedOptIn"`
+	UnsubscribePage         string            `json:"UnsubscribePage"`
+	UnsubscribeSetting      UnsubcribeSetting `json:"UnsubscribeSetting"`
+	ConfirmationSuccessPage string            `json:"ConfirmationSuccessPage"`
+}
+
+// ListDetails gets the settings of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#list_details for more
+// information.
+func (c *APIClient) ListDetails(ctx context.Context, listID string) (*ListDetails, error) {
+	u := fmt.Sprintf("lists/%s.json", listID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var details ListDetails
+	err = c.Do(ctx, req, &details)
+	if err != nil {
+		return nil, err
+	}
+	return &details, nil
+}
+
+// ListUpdateOptions represents the parameters needed to update a list. If
+// AddUnsubscribesToSuppList is set, the list's unsubscribes are added to the
+// client's suppression list, and if ScrubActiveWithSuppList is set, active
+// subscribers on the suppression list are removed from the list. Both only
+// apply when UnsubscribeSetting is changed to AllClientLists.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_a_list for more
+// information.
+type ListUpdateOptions struct {
+	Title                     string            `json:"Title"`
+	UnsubscribePage           string            `json:"UnsubscribePage"`
+	UnsubscribeSetting        UnsubcribeSetting `json:"UnsubscribeSetting"`
+	ConfirmedOptIn            bool              `json:"ConfirmedOptIn"`
+	ConfirmationSuccessPage   string            `json:"ConfirmationSuccessPage"`
+	AddUnsubscribesToSuppList bool              `json:"AddUnsubscribesToSuppList"`
+	ScrubActiveWithSuppList   bool              `json:"ScrubActiveWithSuppList"`
+}
+
+// ListUpdate updates the settings of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_a_list for more
+// information.
+func (c *APIClient) ListUpdate(ctx context.Context, listID string, opt *ListUpdateOptions) error {
+	if opt.UnsubscribeSetting == "" {
+		return errors.New("Unsubscribesetting not set")
+	}
+
+	u := fmt.Sprintf("lists/%s.json", listID)
+
+	req, err := c.NewRequest("PUT", u, opt)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// ListStats represents the subscriber statistics of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#list_stats for more
+// information.
+type ListStats struct {
+	TotalActiveSubscribers        int `json:"TotalActiveSubscribers"`
+	NewActiveSubscribersToday     int `json:"NewActiveSubscribersToday"`
+	NewActiveSubscribersYesterday int `json:"NewActiveSubscribersYesterday"`
+	NewActiveSubscribersThisWeek  int `json:"NewActiveSubscribersThisWeek"`
+	NewActiveSubscribersThisMonth int `json:"NewActiveSubscribersThisMonth"`
+	NewActiveSubscribersThisYear  int `json:"NewActiveSubscribersThisYear"`
+
+	TotalUnsubscribes     int `json:"TotalUnsubscribes"`
+	UnsubscribesToday     int `json:"UnsubscribesToday"`
+	UnsubscribesYesterday int `json:"UnsubscribesYesterday"`
+	UnsubscribesThisWeek  int `json:"UnsubscribesThisWeek"`
+	UnsubscribesThisMonth int `json:"UnsubscribesThisMonth"`
+	UnsubscribesThisYear  int `json:"UnsubscribesThisYear"`
+
+	TotalDeleted     int `json:"TotalDeleted"`
+	DeletedToday     int `json:"DeletedToday"`
+	DeletedYesterday int `json:"DeletedYesterday"`
+	DeletedThisWeek  int `json:"DeletedThisWeek"`
+	DeletedThisMonth int `json:"DeletedThisMonth"`
+	DeletedThisYear  int `json:"DeletedThisYear"`
+
+	TotalBounces     int `json:"TotalBounces"`
+	BouncesToday     int `json:"BouncesToday"`
+	BouncesYesterday int `json:"BouncesYesterday"`
+	BouncesThisWeek  int `json:"BouncesThisWeek"`
+	BouncesThisMonth int `json:"BouncesThisMonth"`
+	BouncesThisYear  int `json:"BouncesThisYear"`
+}
+
+// ListStats gets the subscriber statistics of a list.
+//
+// See https://www.campaignmonitor.com/api/lists/#list_stats for more
+// information.
+func (c *APIClient) ListStats(ctx context.Context, listID string) (*ListStats, error) {
+	u := fmt.Sprintf("lists/%s/stats.json", listID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats ListStats
+	err = c.Do(ctx, req, &stats)
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
 type DataType string
 
 const (
@@ -183,7 +308,7 @@ type CustomFieldDefinition struct {
 //
 // See https://www.campaignmonitor.com/api/lists/#list_custom_fields for
 // more information.
-func (c *APIClient) ListCustomFields(listID string) ([]CustomFieldDefinition, error) {
+func (c *APIClient) ListCustomFields(ctx context.Context, listID string) ([]CustomFieldDefinition, error) {
 	u := fmt.Sprintf("lists/%s/customfields.json", listID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -192,7 +317,7 @@ func (c *APIClient) ListCustomFields(listID string) ([]CustomFieldDefinition, er
 	}
 
 	var result []CustomFieldDefinition
-	err = c.Do(req, &result)
+	err = c.Do(ctx, req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +336,7 @@ type CustomFieldCreate struct {
 //
 // See https://www.campaignmonitor.com/api/lists/#creating_a_custom_field for
 // more information.
-func (c *APIClient) ListCreateCustomField(listID string, def *CustomFieldCreate) (string, error) {
+func (c *APIClient) ListCreateCustomField(ctx context.Context, listID string, def *CustomFieldCreate) (string, error) {
 	u := fmt.Sprintf("lists/%s/customfields.json", listID)
 
 	req, err := c.NewRequest("POST", u, def)
@@ -220,7 +345,42 @@ func (c *APIClient) ListCreateCustomField(listID string, def *CustomFieldCreate)
 	}
 
 	var v interface{}
-	err = c.Do(req, &v)
+	err = c.Do(ctx, req, &v)
+	if err != nil {
+		return "", err
+	}
+
+	r, ok := v.(string)
+	if !ok {
+		return "", errors.New("Return is not a string")
+	}
+
+	return r, nil
+}
+
+// CustomFieldUpdate represents the parameters needed to update a custom
+// field.
+type CustomFieldUpdate struct {
+	FieldName                 string `json:"FieldName"`
+	VisibleInPreferenceCenter bool   `json:"VisibleInPreferenceCenter"`
+}
+
+// ListUpdateCustomField renames a CustomField on the given list and sets
+// whether it is visible in the preference center. It returns the field's new
+// key, which changes when the field is renamed; subscriber values are kept.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_a_custom_field for
+// more information.
+func (c *APIClient) ListUpdateCustomField(ctx context.Context, listID string, cfKey string, upd *CustomFieldUpdate) (string, error) {
+	u := fmt.Sprintf("lists/%s/customfields/%s.json", listID, cfKey)
+
+	req, err := c.NewRequest("PUT", u, upd)
+	if err != nil {
+		return "", err
+	}
+
+	var v interface{}
+	err = c.Do(ctx, req, &v)
 	if err != nil {
 		return "", err
 	}
@@ -233,11 +393,36 @@ func (c *APIClient) ListCreateCustomField(listID string, def *CustomFieldCreate)
 	return r, nil
 }
 
+// CustomFieldOptionsUpdate represents the parameters needed to update the
+// options of a MultiSelectOne or MultiSelectMany custom field. If
+// KeepExistingOptions is set, Options are added to the field's existing
+// options; otherwise they replace them.
+type CustomFieldOptionsUpdate struct {
+	KeepExistingOptions bool     `json:"KeepExistingOptions"`
+	Options             []string `json:"Options"`
+}
+
+// ListUpdateCustomFieldOptions updates the options of a multi-select
+// CustomField on the given list.
+//
+// See https://www.campaignmonitor.com/api/lists/#updating_custom_field_options
+// for more information.
+func (c *APIClient) ListUpdateCustomFieldOptions(ctx context.Context, listID string, cfKey string, upd *CustomFieldOptionsUpdate) error {
+	u := fmt.Sprintf("lists/%s/customfields/%s/options.json", listID, cfKey)
+
+	req, err := c.NewRequest("PUT", u, upd)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
 // ListDeleteCustomField deletes a CustomField from a given list.
 //
 // See https://www.campaignmonitor.com/api/lists/#deleting_a_custom_field for
 // more information.
-func (c *APIClient) ListDeleteCustomField(listID string, cfKey string) error {
+func (c *APIClient) ListDeleteCustomField(ctx context.Context, listID string, cfKey string) error {
 	u := fmt.Sprintf("lists/%s/customfields/%s.json", listID, cfKey)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -245,7 +430,7 @@ func (c *APIClient) ListDeleteCustomField(listID string, cfKey string) error {
 		return err
 	}
 
-	err = c.Do(req, nil)
+	err = c.Do(ctx, req, nil)
 
 	return err
 }
@@ -260,7 +445,7 @@ type ListSegment struct {
 //
 // See https://www.campaignmonitor.com/api/lists/#list_segments for
 // more information.
-func (c *APIClient) ListSegments(listID string) ([]ListSegment, error) {
+func (c *APIClient) ListSegments(ctx context.Context, listID string) ([]ListSegment, error) {
 	u := fmt.Sprintf("lists/%s/segments.json", listID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -269,7 +454,7 @@ func (c *APIClient) ListSegments(listID string) ([]ListSegment, error) {
 	}
 
 	var result []ListSegment
-	err = c.Do(req, &result)
+	err = c.Do(ctx, req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +478,7 @@ type Webhook struct {
 //
 // See https://www.campaignmonitor.com/api/lists/#list_webhooks for
 // more information.
-func (c *APIClient) ListWebhooks(listID string) ([]Webhook, error) {
+func (c *APIClient) ListWebhooks(ctx context.Context, listID string) ([]Webhook, error) {
 	u := fmt.Sprintf("lists/%s/webhooks.json", listID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -302,7 +487,7 @@ func (c *APIClient) ListWebhooks(listID string) ([]Webhook, error) {
 	}
 
 	var result []Webhook
-	err = c.Do(req, &result)
+	err = c.Do(ctx, req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -314,7 +499,7 @@ func (c *APIClient) ListWebhooks(listID string) ([]Webhook, error) {
 //
 // See https://www.campaignmonitor.com/api/lists/#list_webhooks for
 // more information.
-func (c *APIClient) ListCreateWebhook(listID string, webhook *WebhookCreate) (string, error) {
+func (c *APIClient) ListCreateWebhook(ctx context.Context, listID string, webhook *WebhookCreate) (string, error) {
 	u := fmt.Sprintf("lists/%s/webhooks.json", listID)
 
 	req, err := c.NewRequest("POST", u, webhook)
@@ -323,7 +508,7 @@ func (c *APIClient) ListCreateWebhook(listID string, webhook *WebhookCreate) (st
 	}
 
 	var result string
-	err = c.Do(req, &result)
+	err = c.Do(ctx, req, &result)
 	if err != nil {
 		return "", err
 	}
@@ -335,7 +520,7 @@ func (c *APIClient) ListCreateWebhook(listID string, webhook *WebhookCreate) (st
 //
 // See https://www.campaignmonitor.com/api/lists/#testing_a_webhook for
 // more information.
-func (c *APIClient) ListTestWebhook(listID string, webhookID string) error {
+func (c *APIClient) ListTestWebhook(ctx context.Context, listID string, webhookID string) error {
 	u := fmt.Sprintf("lists/%s/webhooks/%s/test.json", listID, webhookID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -343,14 +528,14 @@ func (c *APIClient) ListTestWebhook(listID string, webhookID string) error {
 		return err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
 // ListDeleteWebhook deletes a given webhook for a given list.
 //
 // See https://www.campaignmonitor.com/api/lists/#deleting_a_webhook for
 // more information.
-func (c *APIClient) ListDeleteWebhook(listID string, webhookID string) error {
+func (c *APIClient) ListDeleteWebhook(ctx context.Context, listID string, webhookID string) error {
 	u := fmt.Sprintf("lists/%s/webhooks/%s.json", listID, webhookID)
 
 	req, err := c.NewRequest("DELETE", u, nil)
@@ -358,14 +543,14 @@ func (c *APIClient) ListDeleteWebhook(listID string, webhookID string) error {
 		return err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
 // ListActivateWebhook actives a given webhook for a given list.
 //
 // See https://www.campaignmonitor.com/api/lists/#activating_a_webhook for
 // more information.
-func (c *APIClient) ListActivateWebhook(listID string, webhookID string) error {
+func (c *APIClient) ListActivateWebhook(ctx context.Context, listID string, webhookID string) error {
 	u := fmt.Sprintf("lists/%s/webhooks/%s/activate.json", listID, webhookID)
 
 	req, err := c.NewRequest("PUT", u, nil)
@@ -373,14 +558,14 @@ func (c *APIClient) ListActivateWebhook(listID string, webhookID string) error {
 		return err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
 // ListDeactivateWebhook deactivates a given webhook for a given list.
 //
 // See https://www.campaignmonitor.com/api/lists/#deactivating_a_webhook for
 // more information.
-func (c *APIClient) ListDeactivateWebhook(listID string, webhookID string) error {
+func (c *APIClient) ListDeactivateWebhook(ctx context.Context, listID string, webhookID string) error {
 	u := fmt.Sprintf("lists/%s/webhooks/%s/deactivate.json", listID, webhookID)
 
 	req, err := c.NewRequest("PUT", u, nil)
@@ -388,5 +573,5 @@ func (c *APIClient) ListDeactivateWebhook(listID string, webhookID string) error
 		return err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
@@ -1,6 +1,7 @@
 package createsend
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -24,7 +25,7 @@ func TestListSubscribers(t *testing.T) {
 	    "NumberOfPages": 1}]}`)
 	})
 
-	subs, err := client.ListSubscribers("12CD", ActiveSubscribers, nil)
+	subs, err := client.ListSubscribers(context.Background(), "12CD", ActiveSubscribers, nil)
 	if err != nil {
 		t.Errorf("ListSubscribers returned error: %v", err)
 	}
@@ -51,7 +52,7 @@ func TestListSubscribersEmptyOptions(t *testing.T) {
 	    "NumberOfPages": 1}]}`)
 	})
 
-	subs, err := client.ListSubscribers("12CD", ActiveSubscribers, &ListSubscribersOptions{})
+	subs, err := client.ListSubscribers(context.Background(), "12CD", ActiveSubscribers, &ListSubscribersOptions{})
 	if err != nil {
 		t.Errorf("ListSubscribers returned error: %v", err)
 	}
@@ -84,7 +85,7 @@ func TestListSubscribersOptionsDate(t *testing.T) {
 	    "NumberOfPages": 1}]}`)
 	})
 
-	subs, err := client.ListSubscribers("12CD", ActiveSubscribers, &ListSubscribersOptions{Date: time.Date(2001, time.February, 3, 0, 0, 0, 0, time.UTC)})
+	subs, err := client.ListSubscribers(context.Background(), "12CD", ActiveSubscribers, &ListSubscribersOptions{Date: time.Date(2001, time.February, 3, 0, 0, 0, 0, time.UTC)})
 	if err != nil {
 		t.Errorf("ListSubscribers returned error: %v", err)
 	}
@@ -120,7 +121,7 @@ func TestListSubscribersOptionsPage(t *testing.T) {
 	    "NumberOfPages": 1}]}`)
 	})
 
-	subs, err := client.ListSubscribers("12CD", ActiveSubscribers, &ListSubscribersOptions{Page: 3, PageSize: 123})
+	subs, err := client.ListSubscribers(context.Background(), "12CD", ActiveSubscribers, &ListSubscribersOptions{Page: 3, PageSize: 123})
 	if err != nil {
 		t.Errorf("ListSubscribers returned error: %v", err)
 	}
@@ -156,7 +157,7 @@ func TestListSubscribersOptionsOrder(t *testing.T) {
 	    "NumberOfPages": 1}]}`)
 	})
 
-	subs, err := client.ListSubscribers("12CD", ActiveSubscribers, &ListSubscribersOptions{OrderField: "of", OrderDirection: "desc"})
+	subs, err := client.ListSubscribers(context.Background(), "12CD", ActiveSubscribers, &ListSubscribersOptions{OrderField: "of", OrderDirection: "desc"})
 	if err != nil {
 		t.Errorf("ListSubscribers returned error: %v", err)
 	}
@@ -176,7 +177,7 @@ func TestListCreate(t *testing.T) {
 		fmt.Fprint(w, "\"12CD\"")
 	})
 
-	id, err := client.ListCreate("AAE3", &ListCreateOptions{Title: "Test", UnsubscribeSetting: AllClientLists})
+	id, err := client.ListCreate(context.Background(), "AAE3", &ListCreateOptions{Title: "Test", UnsubscribeSetting: AllClientLists})
 	if err != nil {
 		t.Errorf("ListCreate returned error: %v", err)
 	}
@@ -186,6 +187,100 @@ func TestListCreate(t *testing.T) {
 	}
 }
 
+func TestListDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"ConfirmedOptIn": true,
+			"Title": "Website Subscribers",
+			"UnsubscribePage": "http://www.example.com/unsubscribed.html",
+			"UnsubscribeSetting": "AllClientLists",
+			"ListID": "12CD",
+			"ConfirmationSuccessPage": "http://www.example.com/joined.html"
+		}`)
+	})
+
+	details, err := client.ListDetails(context.Background(), "12CD")
+	if err != nil {
+		t.Errorf("ListDetails returned error: %v", err)
+	}
+
+	want := &ListDetails{
+		ListID:                  "12CD",
+		Title:                   "Website Subscribers",
+		ConfirmedOptIn:          true,
+		UnsubscribePage:         "http://www.example.com/unsubscribed.html",
+		UnsubscribeSetting:      AllClientLists,
+		ConfirmationSuccessPage: "http://www.example.com/joined.html",
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("ListDetails returned %+v, want %+v", details, want)
+	}
+}
+
+func TestListUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"Title":"Website Subscribers","UnsubscribePage":"","UnsubscribeSetting":"AllClientLists","ConfirmedOptIn":false,"ConfirmationSuccessPage":"","AddUnsubscribesToSuppList":true,"ScrubActiveWithSuppList":true}`+"\n")
+	})
+
+	err := client.ListUpdate(context.Background(), "12CD", &ListUpdateOptions{
+		Title:                     "Website Subscribers",
+		UnsubscribeSetting:        AllClientLists,
+		AddUnsubscribesToSuppList: true,
+		ScrubActiveWithSuppList:   true,
+	})
+	if err != nil {
+		t.Errorf("ListUpdate returned error: %v", err)
+	}
+}
+
+func TestListStats(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/lists/12CD/stats.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"TotalActiveSubscribers": 6,
+			"NewActiveSubscribersToday": 1,
+			"NewActiveSubscribersThisYear": 6,
+			"TotalUnsubscribes": 2,
+			"UnsubscribesThisMonth": 1,
+			"TotalDeleted": 3,
+			"DeletedYesterday": 2,
+			"TotalBounces": 4,
+			"BouncesThisWeek": 4
+		}`)
+	})
+
+	stats, err := client.ListStats(context.Background(), "12CD")
+	if err != nil {
+		t.Errorf("ListStats returned error: %v", err)
+	}
+
+	want := &ListStats{
+		TotalActiveSubscribers:       6,
+		NewActiveSubscribersToday:    1,
+		NewActiveSubscribersThisYear: 6,
+		TotalUnsubscribes:            2,
+		UnsubscribesThisMonth:        1,
+		TotalDeleted:                 3,
+		DeletedYesterday:             2,
+		TotalBounces:                 4,
+		BouncesThisWeek:              4,
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("ListStats returned %+v, want %+v", stats, want)
+	}
+}
+
 func TestListDelete(t *testing.T) {
 	setup()
 	defer teardown()
@@ -193,7 +288,7 @@ func TestListDelete(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	err := client.ListDelete("12CD")
+	err := client.ListDelete(context.Background(), "12CD")
 	if err != nil {
 		t.Errorf("ListDelete returned error: %v", err)
 	}
@@ -240,7 +335,7 @@ func TestListCustomFields(t *testing.T) {
 		]`)
 	})
 
-	cfs, err := client.ListCustomFields("12CD")
+	cfs, err := client.ListCustomFields(context.Background(), "12CD")
 	if err != nil {
 		t.Errorf("ListCustomfields returned error: %v", err)
 	}
@@ -268,7 +363,7 @@ func TestListCreateCustomField(t *testing.T) {
 		fmt.Fprint(w, `"AE12"`)
 	})
 
-	id, err := client.ListCreateCustomField("12CD", &CustomFieldCreate{FieldName: "test", DataType: Text, VisibleInPreferenceCenter: false})
+	id, err := client.ListCreateCustomField(context.Background(), "12CD", &CustomFieldCreate{FieldName: "test", DataType: Text, VisibleInPreferenceCenter: false})
 	if err != nil {
 		t.Errorf("ListCreateCustomField return error: %v", err)
 	}
@@ -278,6 +373,39 @@ func TestListCreateCustomField(t *testing.T) {
 	}
 }
 
+func TestListUpdateCustomField(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/lists/12CD/customfields/[test].json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"FieldName":"renamed","VisibleInPreferenceCenter":true}`+"\n")
+		fmt.Fprint(w, `"[renamed]"`)
+	})
+
+	key, err := client.ListUpdateCustomField(context.Background(), "12CD", "[test]", &CustomFieldUpdate{FieldName: "renamed", VisibleInPreferenceCenter: true})
+	if err != nil {
+		t.Errorf("ListUpdateCustomField return error: %v", err)
+	}
+
+	if key != "[renamed]" {
+		t.Errorf("Key returned is wrong: %v", key)
+	}
+}
+
+func TestListUpdateCustomFieldOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/lists/12CD/customfields/[test]/options.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"KeepExistingOptions":true,"Options":["a","b"]}`+"\n")
+	})
+
+	err := client.ListUpdateCustomFieldOptions(context.Background(), "12CD", "[test]", &CustomFieldOptionsUpdate{KeepExistingOptions: true, Options: []string{"a", "b"}})
+	if err != nil {
+		t.Errorf("ListUpdateCustomFieldOptions return error: %v", err)
+	}
+}
+
 func TestListDeleteCustomField(t *testing.T) {
 	setup()
 	defer teardown()
@@ -286,7 +414,7 @@ func TestListDeleteCustomField(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	err := client.ListDeleteCustomField("12CD", "[test]")
+	err := client.ListDeleteCustomField(context.Background(), "12CD", "[test]")
 	if err != nil {
 		t.Errorf("ListDeleteCustomField return error: %v", err)
 	}
@@ -301,7 +429,7 @@ func TestListDeleteCustomFieldFail(t *testing.T) {
 		fmt.Fprint(w, `{"Code":253}`)
 	})
 
-	err := client.ListDeleteCustomField("12CD", "test")
+	err := client.ListDeleteCustomField(context.Background(), "12CD", "test")
 	if err == nil {
 		t.Errorf("ListDeleteCustomField did not return error")
 	}
@@ -327,7 +455,7 @@ func TestListSegments(t *testing.T) {
 				]`)
 	})
 
-	segments, err := client.ListSegments("12CD")
+	segments, err := client.ListSegments(context.Background(), "12CD")
 	if err != nil {
 		t.Errorf("ListSegments returned an error: %v", err)
 	}
@@ -369,7 +497,7 @@ func TestListWebhooks(t *testing.T) {
 			]`)
 	})
 
-	webhooks, err := client.ListWebhooks("12CD")
+	webhooks, err := client.ListWebhooks(context.Background(), "12CD")
 	if err != nil {
 		t.Errorf("ListWebhooks returned an error: %v", err)
 	}
@@ -392,7 +520,7 @@ func TestListCreateWebhook(t *testing.T) {
 		fmt.Fprint(w, `"QWE123"`)
 	})
 
-	id, err := client.ListCreateWebhook("12CD", &WebhookCreate{Events: []string{"Subscribe"}, Url: "http://example.com/subscribe", PayloadFormat: "json"})
+	id, err := client.ListCreateWebhook(context.Background(), "12CD", &WebhookCreate{Events: []string{"Subscribe"}, Url: "http://example.com/subscribe", PayloadFormat: "json"})
 	if err != nil {
 		t.Errorf("ListCreateWebhook returned an error: %v", err)
 	}
@@ -411,7 +539,7 @@ func TestListCreateWebhookFail(t *testing.T) {
 		fmt.Fprint(w, `{"Code" : 602}`)
 	})
 
-	_, err := client.ListCreateWebhook("12CD", &WebhookCreate{Events: []string{"Subscribe"}, Url: "http://example.com/subscribe"})
+	_, err := client.ListCreateWebhook(context.Background(), "12CD", &WebhookCreate{Events: []string{"Subscribe"}, Url: "http://example.com/subscribe"})
 	if err == nil {
 		t.Errorf("ListCreateWebhook did not return an error")
 	}
@@ -425,7 +553,7 @@ func TestListTestWebhook(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	err := client.ListTestWebhook("12CD", "QWE123")
+	err := client.ListTestWebhook(context.Background(), "12CD", "QWE123")
 	if err != nil {
 		t.Errorf("ListTestWebhook returned an error: %v", err)
 	}
@@ -449,7 +577,7 @@ func TestListTestWebhookFail(t *testing.T) {
 			}`)
 	})
 
-	err := client.ListTestWebhook("12CD", "QWE123")
+	err := client.ListTestWebhook(context.Background(), "12CD", "QWE123")
 	if err == nil {
 		t.Errorf("ListTestWebhook did not return an error")
 	}
@@ -463,7 +591,7 @@ func TestListDeleteWebhook(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	err := client.ListDeleteWebhook("12CD", "QWE123")
+	err := client.ListDeleteWebhook(context.Background(), "12CD", "QWE123")
 	if err != nil {
 		t.Errorf("ListDeleteWebhook returned an error: %v", err)
 	}
@@ -477,7 +605,7 @@ func TestListActivateWebhook(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	err := client.ListActivateWebhook("12CD", "QWE123")
+	err := client.ListActivateWebhook(context.Background(), "12CD", "QWE123")
 	if err != nil {
 		t.Errorf("ListActivateWebhook returned an error: %v", err)
 	}
@@ -492,7 +620,7 @@ func TestListDeactivateWebhook(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	err := client.ListDeactivateWebhook("12CD", "QWE123")
+	err := client.ListDeactivateWebhook(context.Background(), "12CD", "QWE123")
 	if err != nil {
 		t.Errorf("ListDeactivateWebhook returned an error: %v", err)
 	}
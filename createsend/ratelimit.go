@@ -0,0 +1,118 @@
+package createsend
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit represents the API rate limit status reported by the
+// X-RateLimit-* headers of a Campaign Monitor API response.
+//
+// See https://www.campaignmonitor.com/api/getting-started/#rate-limiting for
+// more information.
+type RateLimit struct {
+	// Limit is the number of requests allowed in each rate limit window.
+	Limit int
+
+	// Remaining is the number of requests remaining in the current window.
+	Remaining int
+
+	// Reset is the time at which the current window ends.
+	Reset time.Time
+}
+
+// parseRateLimit parses the X-RateLimit-* headers in h. X-RateLimit-Reset is
+// expressed in seconds from now. The boolean result is false if h carries no
+// rate limit information.
+func parseRateLimit(h http.Header, now time.Time) (RateLimit, bool) {
+	var rl RateLimit
+	var err error
+	if rl.Remaining, err = strconv.Atoi(h.Get("X-RateLimit-Remaining")); err != nil {
+		return RateLimit{}, false
+	}
+	rl.Limit, _ = strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if secs, err := strconv.Atoi(h.Get("X-RateLimit-Reset")); err == nil && secs >= 0 {
+		rl.Reset = now.Add(time.Duration(secs) * time.Second)
+	}
+	return rl, true
+}
+
+// RateLimit returns the rate limit status reported by the most recent API
+// response that carried one. It returns the zero RateLimit if no such
+// response has been received yet.
+func (c *APIClient) RateLimit() RateLimit {
+	c.rate.mu.Lock()
+	defer c.rate.mu.Unlock()
+	return c.rate.last
+}
+
+// rateLimiter is a token bucket shared by all requests sent by an APIClient.
+// The bucket holds the number of requests the server says remain in the
+// current window and is refilled when the window resets.
+type rateLimiter struct {
+	mu     sync.Mutex
+	last   RateLimit
+	tokens int           // requests that may still be sent before reset
+	reset  time.Time     // when tokens is refilled; zero if unknown
+	window time.Duration // estimated length of a window
+}
+
+// update records the rate limit status reported by a response.
+func (l *rateLimiter) update(rl RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if rl.Reset.After(l.reset.Add(time.Second)) {
+		// A new window has started.
+		l.tokens = rl.Remaining
+	} else if rl.Remaining < l.tokens {
+		l.tokens = rl.Remaining
+	}
+	l.reset = rl.Reset
+	l.last = rl
+
+	// The longest time to reset seen so far is the best estimate of the
+	// window's length.
+	if w := time.Until(rl.Reset); w > l.window {
+		l.window = w
+	}
+}
+
+// wait takes a token from the bucket, blocking until the current window
+// resets if the bucket is empty. It returns ctx.Err() if ctx is done first.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		if !l.reset.IsZero() && !now.Before(l.reset) {
+			if l.last.Limit > 0 && l.window > 0 {
+				// The window has reset. Refill the bucket and, until a
+				// response reports the new window, assume it is as long as
+				// the previous ones.
+				l.tokens = l.last.Limit
+				l.reset = l.reset.Add((now.Sub(l.reset)/l.window + 1) * l.window)
+			} else {
+				l.reset = time.Time{}
+			}
+		}
+		switch {
+		case l.reset.IsZero():
+			// Nothing is known about the current window.
+			l.mu.Unlock()
+			return nil
+		case l.tokens > 0:
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		d := l.reset.Sub(now)
+		l.mu.Unlock()
+
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package createsend
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPIClient_RateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "1000")
+		w.Header().Set("X-RateLimit-Remaining", "999")
+		w.Header().Set("X-RateLimit-Reset", "3600")
+	})
+
+	if rl := client.RateLimit(); rl != (RateLimit{}) {
+		t.Errorf("RateLimit before any request = %+v, want zero value", rl)
+	}
+
+	start := time.Now()
+	req, _ := client.NewRequest("GET", "/", nil)
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	rl := client.RateLimit()
+	if rl.Limit != 1000 || rl.Remaining != 999 {
+		t.Errorf("RateLimit = %+v, want Limit 1000 and Remaining 999", rl)
+	}
+	if reset := start.Add(time.Hour); rl.Reset.Before(reset) || rl.Reset.After(reset.Add(time.Minute)) {
+		t.Errorf("RateLimit Reset = %v, want about %v", rl.Reset, reset)
+	}
+}
+
+func TestDo_blockOnRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.BlockOnRateLimit = true
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-RateLimit-Limit", "1")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "3600")
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ = client.NewRequest("GET", "/", nil)
+	if err := client.Do(ctx, req, nil); err != context.DeadlineExceeded {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if calls != 1 {
+		t.Errorf("Server received %d requests, want %d", calls, 1)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	var l rateLimiter
+	ctx := context.Background()
+
+	// Without any rate limit information, requests are never blocked.
+	if err := l.wait(ctx); err != nil {
+		t.Fatalf("wait returned error: %v", err)
+	}
+
+	l.update(RateLimit{Limit: 10, Remaining: 2, Reset: time.Now().Add(time.Hour)})
+	for i := 0; i < 2; i++ {
+		if err := l.wait(ctx); err != nil {
+			t.Fatalf("wait %d returned error: %v", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("wait on empty bucket returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// Once the window resets, the bucket is refilled.
+	l.update(RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(-time.Second)})
+	if err := l.wait(context.Background()); err != nil {
+		t.Errorf("wait after reset returned error: %v", err)
+	}
+	if l.tokens != 9 {
+		t.Errorf("tokens after reset = %d, want %d", l.tokens, 9)
+	}
+}
+
+func TestRateLimiter_concurrent(t *testing.T) {
+	var l rateLimiter
+	l.update(RateLimit{Limit: 2, Remaining: 0, Reset: time.Now().Add(100 * time.Millisecond)})
+
+	// The bucket is refilled with 2 tokens after 100ms, and again after
+	// 200ms, so only 2 of the waiters get through before their deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+
+	const n = 10
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func() { errs <- l.wait(ctx) }()
+	}
+
+	var passed int
+	for i := 0; i < n; i++ {
+		switch err := <-errs; err {
+		case nil:
+			passed++
+		case context.DeadlineExceeded:
+		default:
+			t.Errorf("wait returned error: %v", err)
+		}
+	}
+	if passed != 2 {
+		t.Errorf("%d waiters got through after reset, want %d", passed, 2)
+	}
+}
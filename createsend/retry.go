@@ -0,0 +1,136 @@
+package createsend
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures how APIClient.Do retries requests that fail with a
+// transport error, an HTTP 429 (Too Many Requests) or an HTTP 5xx response.
+//
+// Between attempts the client waits for an exponentially increasing, jittered
+// backoff. If the response carries a Retry-After header, or reports an
+// exhausted rate limit via the X-RateLimit-Remaining and X-RateLimit-Reset
+// headers, the server-provided delay is used instead. Requests whose
+// server-provided delay exceeds MaxBackoff are not retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including
+	// the first attempt. Values less than 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry. It doubles with each
+	// subsequent attempt. Defaults to 500ms.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts. Defaults to 30s.
+	MaxBackoff time.Duration
+
+	// Retryable reports whether req may be sent more than once. If nil,
+	// IdempotentRequest is used, so POST requests are never retried. To opt
+	// POST endpoints such as ImportSubscribers in, provide a func that also
+	// matches their paths.
+	Retryable func(req *http.Request) bool
+}
+
+// IdempotentRequest reports whether req uses an idempotent HTTP method (GET,
+// HEAD, OPTIONS, PUT or DELETE).
+func IdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// retryDelay reports whether the request should be sent again after an
+// attempt that returned resp and err, and if so how long to wait first.
+func (p *RetryPolicy) retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = IdempotentRequest
+	}
+	if !retryable(req) {
+		return 0, false
+	}
+
+	// The body has already been consumed and cannot be sent again.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	if err == nil {
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+			return 0, false
+		}
+		if d, ok := serverDelay(resp); ok {
+			if d > maxBackoff {
+				return 0, false
+			}
+			return d, true
+		}
+	}
+
+	return p.backoff(attempt, maxBackoff), true
+}
+
+// backoff returns the jittered exponential delay to wait after the given
+// (1-based) attempt.
+func (p *RetryPolicy) backoff(attempt int, maxBackoff time.Duration) time.Duration {
+	d := p.MinBackoff
+	if d <= 0 {
+		d = defaultMinBackoff
+	}
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+
+	// Wait between d/2 and d so that clients retrying at the same time
+	// spread out.
+	half := int64(d / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// serverDelay returns how long the server asked us to wait before sending
+// another request, either in the Retry-After header (in seconds or as an HTTP
+// date) or, if the rate limit is exhausted, in the X-RateLimit-Reset header
+// (in seconds).
+func serverDelay(resp *http.Response) (time.Duration, bool) {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			d := time.Until(t)
+			if d < 0 {
+				d = 0
+			}
+			return d, true
+		}
+	}
+
+	now := time.Now()
+	if rl, ok := parseRateLimit(resp.Header, now); ok && rl.Remaining == 0 && !rl.Reset.IsZero() {
+		return rl.Reset.Sub(now), true
+	}
+
+	return 0, false
+}
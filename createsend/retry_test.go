@@ -0,0 +1,182 @@
+package createsend
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDo_retry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	var body struct{ A string }
+	err := client.Do(context.Background(), req, &body)
+	if err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Do sent %d requests, want %d", calls, 3)
+	}
+	if body.A != "a" {
+		t.Errorf("Response body A = %q, want %q", body.A, "a")
+	}
+}
+
+func TestDo_retryExhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	err := client.Do(context.Background(), req, nil)
+	if err == nil {
+		t.Error("Expected HTTP 500 error.")
+	}
+	if calls != 2 {
+		t.Errorf("Do sent %d requests, want %d", calls, 2)
+	}
+}
+
+func TestDo_retryResendsBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}
+
+	var bodies []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "0")
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		}
+	})
+
+	req, _ := client.NewRequest("PUT", "/", map[string]string{"A": "a"})
+	err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+	want := `{"A":"a"}` + "\n"
+	if len(bodies) != 2 || bodies[0] != want || bodies[1] != want {
+		t.Errorf("Request bodies = %q, want 2 x %q", bodies, want)
+	}
+}
+
+func TestDo_retrySkipsPOST(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest("POST", "/", nil)
+	client.Do(context.Background(), req, nil)
+	if calls != 1 {
+		t.Errorf("Do sent %d requests, want %d", calls, 1)
+	}
+}
+
+func TestDo_retryPOSTOptIn(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		Retryable: func(req *http.Request) bool {
+			return IdempotentRequest(req) || strings.HasSuffix(req.URL.Path, "/import.json")
+		},
+	}
+
+	var calls int
+	mux.HandleFunc("/subscribers/12CD/import.json", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			http.Error(w, "Bad Gateway", http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := client.ImportSubscribers(context.Background(), "12CD", ImportSubscribers{})
+	if err != nil {
+		t.Errorf("ImportSubscribers returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("ImportSubscribers sent %d requests, want %d", calls, 2)
+	}
+}
+
+func TestDo_retryServerDelayTooLong(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Second}
+
+	var calls int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "3600")
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	client.Do(context.Background(), req, nil)
+	if calls != 1 {
+		t.Errorf("Do sent %d requests, want %d", calls, 1)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 200 * time.Millisecond, 400 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+	for _, tt := range tests {
+		d := p.backoff(tt.attempt, p.MaxBackoff)
+		if d < tt.min || d > tt.max {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", tt.attempt, d, tt.min, tt.max)
+		}
+	}
+}
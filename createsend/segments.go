@@ -1,6 +1,9 @@
 package createsend
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type SegmentCreate struct {
 	Title      string            `json:"Title"`
@@ -20,7 +23,7 @@ type RuleCreate struct {
 //
 // See https://www.campaignmonitor.com/api/segments/#creating_a_segment for more
 // information.
-func (c *APIClient) SegmentCreate(listID string, sgmt *SegmentCreate) (string, error) {
+func (c *APIClient) SegmentCreate(ctx context.Context, listID string, sgmt *SegmentCreate) (string, error) {
 	u := fmt.Sprintf("segments/%s.json", listID)
 
 	req, err := c.NewRequest("POST", u, sgmt)
@@ -29,7 +32,7 @@ func (c *APIClient) SegmentCreate(listID string, sgmt *SegmentCreate) (string, e
 	}
 
 	var r string
-	err = c.Do(req, &r)
+	err = c.Do(ctx, req, &r)
 	if err != nil {
 		return "", err
 	}
@@ -41,7 +44,7 @@ func (c *APIClient) SegmentCreate(listID string, sgmt *SegmentCreate) (string, e
 //
 // See https://www.campaignmonitor.com/api/segments/#updating_a_segment for more
 // information.
-func (c *APIClient) SegmentUpdate(segmentID string, sgmt *SegmentCreate) error {
+func (c *APIClient) SegmentUpdate(ctx context.Context, segmentID string, sgmt *SegmentCreate) error {
 	u := fmt.Sprintf("segments/%s.json", segmentID)
 
 	req, err := c.NewRequest("PUT", u, sgmt)
@@ -49,7 +52,7 @@ func (c *APIClient) SegmentUpdate(segmentID string, sgmt *SegmentCreate) error {
 		return err
 	}
 
-	err = c.Do(req, nil)
+	err = c.Do(ctx, req, nil)
 
 	return err
 }
@@ -66,7 +69,7 @@ type SegmentDetail struct {
 //
 // See https://www.campaignmonitor.com/api/segments/#getting_a_segments_details for more
 // information.
-func (c *APIClient) SegmentDetail(segmentID string) (*SegmentDetail, error) {
+func (c *APIClient) SegmentDetail(ctx context.Context, segmentID string) (*SegmentDetail, error) {
 	u := fmt.Sprintf("segments/%s.json", segmentID)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -75,7 +78,7 @@ func (c *APIClient) SegmentDetail(segmentID string) (*SegmentDetail, error) {
 	}
 
 	var s SegmentDetail
-	err = c.Do(req, &s)
+	err = c.Do(ctx, req, &s)
 	if err != nil {
 		return nil, err
 	}
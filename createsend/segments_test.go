@@ -1,6 +1,7 @@
 package createsend
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -20,7 +21,7 @@ func TestSegmentCreate(t *testing.T) {
 	rg[0].Rules = []RuleCreate{RuleCreate{RuleType: "DateSubscribed", Clause: "AFTER 2009-01-01"}}
 
 	sgmt := SegmentCreate{Title: "Test", RuleGroups: rg}
-	id, err := client.SegmentCreate("12CD", &sgmt)
+	id, err := client.SegmentCreate(context.Background(), "12CD", &sgmt)
 
 	if err != nil {
 		t.Errorf("SegmentCreate returned error: %v", err)
@@ -46,7 +47,7 @@ func TestSegmentCreateFail(t *testing.T) {
 	rg[0].Rules = []RuleCreate{RuleCreate{RuleType: "DateSubscribed", Clause: "AFTER 2009-01-01"}}
 
 	sgmt := SegmentCreate{Title: "Test", RuleGroups: rg}
-	_, err := client.SegmentCreate("12CD", &sgmt)
+	_, err := client.SegmentCreate(context.Background(), "12CD", &sgmt)
 
 	if err == nil {
 		t.Errorf("SegmentCreate returned no error")
@@ -90,7 +91,7 @@ func TestSegmentDetail(t *testing.T) {
 			}`)
 	})
 
-	s, err := client.SegmentDetail("12CD")
+	s, err := client.SegmentDetail(context.Background(), "12CD")
 	if err != nil {
 		t.Errorf("SegmentDetail returned an error")
 	}
@@ -115,7 +116,7 @@ func TestSegmentUpdate(t *testing.T) {
 	})
 
 	sgmt := SegmentCreate{Title: "test"}
-	err := client.SegmentUpdate("12CD", &sgmt)
+	err := client.SegmentUpdate(context.Background(), "12CD", &sgmt)
 
 	if err != nil {
 		t.Errorf("SegmentUpdate returned an error")
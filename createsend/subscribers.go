@@ -1,6 +1,9 @@
 package createsend
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 )
@@ -24,13 +27,16 @@ type NewSubscriber struct {
 type CustomField struct {
 	Key   string
 	Value interface{}
+
+	// Clear removes the field's value when updating a subscriber.
+	Clear bool `json:",omitempty"`
 }
 
 // AddSubscriber adds a subscriber.
 //
 // See http://www.campaignmonitor.com/api/subscribers/#adding_a_subscriber for
 // more information.
-func (c *APIClient) AddSubscriber(listID string, sub NewSubscriber) error {
+func (c *APIClient) AddSubscriber(ctx context.Context, listID string, sub NewSubscriber) error {
 	u := fmt.Sprintf("subscribers/%s.json", listID)
 
 	req, err := c.NewRequest("POST", u, sub)
@@ -38,14 +44,14 @@ func (c *APIClient) AddSubscriber(listID string, sub NewSubscriber) error {
 		return err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
 // UpdateSubscriber updates a subscriber.
 //
 // See http://www.campaignmonitor.com/api/subscribers/#updating_a_subscriber for
 // more information.
-func (c *APIClient) UpdateSubscriber(listID string, email string, sub NewSubscriber) error {
+func (c *APIClient) UpdateSubscriber(ctx context.Context, listID string, email string, sub NewSubscriber) error {
 	u := fmt.Sprintf("subscribers/%s.json?email=%s", listID, email)
 
 	req, err := c.NewRequest("PUT", u, sub)
@@ -53,7 +59,7 @@ func (c *APIClient) UpdateSubscriber(listID string, email string, sub NewSubscri
 		return err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
 // Subscriber represents a subscriber.
@@ -81,7 +87,7 @@ type Subscriber struct {
 // See
 // http://www.campaignmonitor.com/api/subscribers/#getting_a_subscribers_details
 // for more information.
-func (c *APIClient) GetSubscriber(listID string, email string) (*Subscriber, error) {
+func (c *APIClient) GetSubscriber(ctx context.Context, listID string, email string) (*Subscriber, error) {
 	u := fmt.Sprintf("subscribers/%s.json?email=%s", listID, email)
 
 	req, err := c.NewRequest("GET", u, nil)
@@ -90,7 +96,7 @@ func (c *APIClient) GetSubscriber(listID string, email string) (*Subscriber, err
 	}
 
 	var sub Subscriber
-	err = c.Do(req, &sub)
+	err = c.Do(ctx, req, &sub)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +116,7 @@ func (c *APIClient) GetSubscriber(listID string, email string) (*Subscriber, err
 // See
 // http://www.campaignmonitor.com/api/subscribers/#unsubscribing_a_subscriber
 // for more information.
-func (c *APIClient) Unsubscribe(listID string, email string) error {
+func (c *APIClient) Unsubscribe(ctx context.Context, listID string, email string) error {
 	u := fmt.Sprintf("subscribers/%s/unsubscribe.json", listID)
 
 	req, err := c.NewRequest("POST", u, struct{ EmailAddress string }{email})
@@ -118,7 +124,7 @@ func (c *APIClient) Unsubscribe(listID string, email string) error {
 		return err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
 // Delete removes the Subscriber from the specified list
@@ -126,7 +132,7 @@ func (c *APIClient) Unsubscribe(listID string, email string) error {
 // See
 // https://www.campaignmonitor.com/api/subscribers/#deleting_a_subscriber
 // for more information.
-func (c *APIClient) DeleteSubscriber(listID string, email string) error {
+func (c *APIClient) DeleteSubscriber(ctx context.Context, listID string, email string) error {
 	u := fmt.Sprintf("subscribers/%s.json?email=%s", listID, email)
 
 	req, err := c.NewRequest("DELETE", u, struct{ EmailAddress string }{email})
@@ -134,7 +140,7 @@ func (c *APIClient) DeleteSubscriber(listID string, email string) error {
 		return err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
 // NewSubscriber represents a new subscriber to be added with AddSubscriber.
@@ -154,12 +160,36 @@ type ImportSubscribers struct {
 	RestartSubscriptionBasedAutoresponders bool `json:",omitempty"`
 }
 
+// ImportResult represents the outcome of ImportSubscribers.
+//
+// See
+// https://www.campaignmonitor.com/api/subscribers/#importing_many_subscribers
+// for more information.
+type ImportResult struct {
+	FailureDetails              []ImportFailure
+	TotalUniqueEmailsSubmitted  int
+	TotalExistingSubscribers    int
+	TotalNewSubscribers         int
+	DuplicateEmailsInSubmission []string
+}
+
+// ImportFailure describes a subscriber that could not be imported.
+type ImportFailure struct {
+	EmailAddress string
+	Code         int
+	Message      string
+}
+
 // Importing many subscribes
 //
+// If some of the subscribers could not be imported, the API responds with
+// error code 210 and ImportSubscribers returns both the error and an
+// ImportResult whose FailureDetails describe the rejected subscribers.
+//
 // See
 // https://www.campaignmonitor.com/api/subscribers/#importing_many_subscribers
 // for more information.
-func (c *APIClient) ImportSubscribers(listID string, importSubscribers ImportSubscribers) (interface{}, error) {
+func (c *APIClient) ImportSubscribers(ctx context.Context, listID string, importSubscribers ImportSubscribers) (*ImportResult, error) {
 	u := fmt.Sprintf("subscribers/%s/import.json", listID)
 
 	req, err := c.NewRequest("POST", u, importSubscribers)
@@ -167,7 +197,18 @@ func (c *APIClient) ImportSubscribers(listID string, importSubscribers ImportSub
 		return nil, err
 	}
 
-	var v interface{}
-	err = c.Do(req, &v)
-	return v, err
+	var result ImportResult
+	err = c.Do(ctx, req, &result)
+	if err != nil {
+		var e *ErrorResponse
+		if !errors.As(err, &e) {
+			return nil, err
+		}
+		var body struct{ ResultData *ImportResult }
+		if json.Unmarshal(e.Body, &body) != nil || body.ResultData == nil {
+			return nil, err
+		}
+		return body.ResultData, err
+	}
+	return &result, nil
 }
@@ -1,6 +1,8 @@
 package createsend
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -21,7 +23,7 @@ func TestAddSubscriber(t *testing.T) {
 		EmailAddress: "alice@example.com",
 		Name:         "Alice",
 	}
-	err := client.AddSubscriber("12CD", sub)
+	err := client.AddSubscriber(context.Background(), "12CD", sub)
 	if err != nil {
 		t.Errorf("AddSubscriber returned error: %v", err)
 	}
@@ -41,7 +43,7 @@ func TestUpdateSubscriber(t *testing.T) {
 		EmailAddress: "alice@example.net",
 		Name:         "Alice",
 	}
-	err := client.UpdateSubscriber("12CD", "alice@example.com", sub)
+	err := client.UpdateSubscriber(context.Background(), "12CD", "alice@example.com", sub)
 	if err != nil {
 		t.Errorf("AddSubscriber returned error: %v", err)
 	}
@@ -63,7 +65,7 @@ func TestGetSubscriber(t *testing.T) {
 		Date:         time.Date(2010, 10, 25, 10, 28, 0, 0, time.UTC),
 		DateStr:      "2010-10-25T10:28:00Z",
 	}
-	sub, err := client.GetSubscriber("12CD", "alice@example.com")
+	sub, err := client.GetSubscriber(context.Background(), "12CD", "alice@example.com")
 	if err != nil {
 		t.Errorf("GetSubscriber returned error: %v", err)
 	}
@@ -82,10 +84,14 @@ func TestGetSubscriber_NotInList(t *testing.T) {
 	})
 
 	want := &CreatesendError{Code: 203, Message: "Subscriber not in list"}
-	sub, err := client.GetSubscriber("12CD", "alice@example.com")
-	if !reflect.DeepEqual(err, want) {
+	sub, err := client.GetSubscriber(context.Background(), "12CD", "alice@example.com")
+	var cerr *CreatesendError
+	if !errors.As(err, &cerr) || !reflect.DeepEqual(cerr, want) {
 		t.Errorf("GetSubscriber returned error %+v, want %+v", err, want)
 	}
+	if !errors.Is(err, &CreatesendError{Code: 203}) {
+		t.Errorf("GetSubscriber returned error %+v, want it to match code 203", err)
+	}
 	if sub != nil {
 		t.Errorf("GetSubscriber returned non-nil subscriber %+v", sub)
 	}
@@ -99,7 +105,7 @@ func TestUnsubscribe(t *testing.T) {
 		testMethod(t, r, "POST")
 	})
 
-	err := client.Unsubscribe("12CD", "alice@example.com")
+	err := client.Unsubscribe(context.Background(), "12CD", "alice@example.com")
 	if err != nil {
 		t.Errorf("Unsubscribe returned error: %v", err)
 	}
@@ -119,10 +125,15 @@ func TestImportSubscribers(t *testing.T) {
 
 	im := ImportSubscribers{Subscribers: []ImportSubscriber{s1, s2}}
 
-	_, err := client.ImportSubscribers("12CD", im)
+	res, err := client.ImportSubscribers(context.Background(), "12CD", im)
 	if err != nil {
 		t.Errorf("ImportSubcribers returned error: %v", err)
 	}
+
+	want := &ImportResult{FailureDetails: []ImportFailure{}, TotalUniqueEmailsSubmitted: 3, TotalNewSubscribers: 2, DuplicateEmailsInSubmission: []string{}}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("ImportSubscribers returned %+v, want %+v", res, want)
+	}
 }
 
 func TestImportSubscribersFailed(t *testing.T) {
@@ -155,9 +166,39 @@ func TestImportSubscribersFailed(t *testing.T) {
 
 	im := ImportSubscribers{Subscribers: []ImportSubscriber{s1, s2}}
 
-	_, err := client.ImportSubscribers("12CD", im)
-	if err == nil {
-		t.Error("ImportSubcribers returned no error")
+	res, err := client.ImportSubscribers(context.Background(), "12CD", im)
+	if !errors.Is(err, &CreatesendError{Code: 210}) {
+		t.Errorf("ImportSubcribers returned error %v, want code 210", err)
+	}
+
+	want := &ImportResult{
+		FailureDetails:              []ImportFailure{{EmailAddress: "example+1@example", Code: 1, Message: "Invalid Email Address"}},
+		TotalUniqueEmailsSubmitted:  3,
+		TotalExistingSubscribers:    2,
+		DuplicateEmailsInSubmission: []string{},
+	}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("ImportSubscribers returned %+v, want %+v", res, want)
+	}
+}
+
+func TestImportSubscribers_errorWithoutResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/subscribers/12CD/import.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"Code":50,"Message":"Must supply a valid HTTP Basic Authorization header"}`)
+	})
+
+	im := ImportSubscribers{Subscribers: []ImportSubscriber{{EmailAddress: "alice@example.com"}}}
+
+	res, err := client.ImportSubscribers(context.Background(), "12CD", im)
+	if !IsUnauthorized(err) {
+		t.Errorf("ImportSubscribers returned error %v, want unauthorized", err)
+	}
+	if res != nil {
+		t.Errorf("ImportSubscribers returned %+v, want nil", res)
 	}
 }
 
@@ -168,7 +209,7 @@ func TestDeleteSubscriber(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	err := client.DeleteSubscriber("12CD", "alice@example.com")
+	err := client.DeleteSubscriber(context.Background(), "12CD", "alice@example.com")
 
 	if err != nil {
 		t.Error("DeleteSubscriber returned an error")
@@ -183,7 +224,7 @@ func TestDeleteSubscriberFail(t *testing.T) {
 		fmt.Fprint(w, `{"Code" : 1}`)
 	})
 
-	err := client.DeleteSubscriber("12CD", "alice@example.com")
+	err := client.DeleteSubscriber(context.Background(), "12CD", "alice@example.com")
 
 	if err == nil {
 		t.Error("DeleteSubscriber did not return an error")
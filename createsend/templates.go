@@ -0,0 +1,101 @@
+package createsend
+
+import (
+	"context"
+	"fmt"
+)
+
+// Template represents an email template belonging to a client.
+//
+// See https://www.campaignmonitor.com/api/templates/#getting_a_template for
+// more information.
+type Template struct {
+	TemplateID    string `json:"TemplateID"`
+	Name          string `json:"Name"`
+	PreviewURL    string `json:"PreviewURL"`
+	ScreenshotURL string `json:"ScreenshotURL"`
+}
+
+// TemplateCreate represents the parameters needed to create or update a
+// template. HtmlPageURL must point to the template's HTML page, and
+// ZipFileURL, if set, to a zip file of the images it uses.
+//
+// See https://www.campaignmonitor.com/api/templates/#creating_a_template for
+// more information.
+type TemplateCreate struct {
+	Name        string `json:"Name"`
+	HtmlPageURL string `json:"HtmlPageURL"`
+	ZipFileURL  string `json:"ZipFileURL,omitempty"`
+}
+
+// CreateTemplate creates a new template for a client and returns its ID.
+//
+// See https://www.campaignmonitor.com/api/templates/#creating_a_template for
+// more information.
+func (c *APIClient) CreateTemplate(ctx context.Context, clientID string, tmpl *TemplateCreate) (string, error) {
+	u := fmt.Sprintf("templates/%s.json", clientID)
+
+	req, err := c.NewRequest("POST", u, tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	err = c.Do(ctx, req, &id)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// TemplateDetails returns the details of a template.
+//
+// See https://www.campaignmonitor.com/api/templates/#getting_a_template for
+// more information.
+func (c *APIClient) TemplateDetails(ctx context.Context, templateID string) (*Template, error) {
+	u := fmt.Sprintf("templates/%s.json", templateID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tmpl Template
+	err = c.Do(ctx, req, &tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tmpl, nil
+}
+
+// UpdateTemplate replaces the name and content of a template.
+//
+// See https://www.campaignmonitor.com/api/templates/#updating_a_template for
+// more information.
+func (c *APIClient) UpdateTemplate(ctx context.Context, templateID string, tmpl *TemplateCreate) error {
+	u := fmt.Sprintf("templates/%s.json", templateID)
+
+	req, err := c.NewRequest("PUT", u, tmpl)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
+
+// DeleteTemplate deletes a template.
+//
+// See https://www.campaignmonitor.com/api/templates/#deleting_a_template for
+// more information.
+func (c *APIClient) DeleteTemplate(ctx context.Context, templateID string) error {
+	u := fmt.Sprintf("templates/%s.json", templateID)
+
+	req, err := c.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Do(ctx, req, nil)
+}
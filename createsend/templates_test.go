@@ -0,0 +1,87 @@
+package createsend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCreateTemplate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/12ab.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"Name":"Newsletter","HtmlPageURL":"http://example.com/newsletter.html","ZipFileURL":"http://example.com/files.zip"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `"98y2e98y289dh89h938389"`)
+	})
+
+	id, err := client.CreateTemplate(context.Background(), "12ab", &TemplateCreate{
+		Name:        "Newsletter",
+		HtmlPageURL: "http://example.com/newsletter.html",
+		ZipFileURL:  "http://example.com/files.zip",
+	})
+	if err != nil {
+		t.Errorf("CreateTemplate returned error: %v", err)
+	}
+	if id != "98y2e98y289dh89h938389" {
+		t.Errorf("CreateTemplate returned %q, want %q", id, "98y2e98y289dh89h938389")
+	}
+}
+
+func TestTemplateDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/98y2.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"TemplateID": "98y2", "Name": "Template One", "PreviewURL": "http://preview.createsend.com/templates/publicPreview/01AF532CD8889B33?d=r", "ScreenshotURL": "http://preview.createsend.com/ts/r/14/833/263/14833263.jpg?0318092600"}`)
+	})
+
+	tmpl, err := client.TemplateDetails(context.Background(), "98y2")
+	if err != nil {
+		t.Errorf("TemplateDetails returned error: %v", err)
+	}
+
+	want := &Template{
+		TemplateID:    "98y2",
+		Name:          "Template One",
+		PreviewURL:    "http://preview.createsend.com/templates/publicPreview/01AF532CD8889B33?d=r",
+		ScreenshotURL: "http://preview.createsend.com/ts/r/14/833/263/14833263.jpg?0318092600",
+	}
+	if !reflect.DeepEqual(tmpl, want) {
+		t.Errorf("TemplateDetails returned %+v, want %+v", tmpl, want)
+	}
+}
+
+func TestUpdateTemplate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/98y2.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"Name":"Newsletter","HtmlPageURL":"http://example.com/newsletter.html"}`+"\n")
+	})
+
+	err := client.UpdateTemplate(context.Background(), "98y2", &TemplateCreate{Name: "Newsletter", HtmlPageURL: "http://example.com/newsletter.html"})
+	if err != nil {
+		t.Errorf("UpdateTemplate returned error: %v", err)
+	}
+}
+
+func TestDeleteTemplate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/templates/98y2.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	err := client.DeleteTemplate(context.Background(), "98y2")
+	if err != nil {
+		t.Errorf("DeleteTemplate returned error: %v", err)
+	}
+}
@@ -0,0 +1,230 @@
+package createsend
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LocalTemplate is a template read by LocalTemplates. Hash identifies the
+// content of its files.
+type LocalTemplate struct {
+	*TemplateCreate
+	Hash string
+}
+
+// LocalTemplates reads a directory of templates. Each template is an HTML file
+// named NAME.html, optionally accompanied by a NAME.zip file of the images it
+// uses. Since Campaign Monitor imports templates from URLs, the directory must
+// be published at baseURL (for example by a CI job), and the returned
+// templates' HtmlPageURL and ZipFileURL are resolved against it. Their Hash is
+// the SHA-256 of both files.
+func LocalTemplates(dir string, baseURL *url.URL) ([]*LocalTemplate, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []*LocalTemplate
+	for _, fi := range entries {
+		if fi.IsDir() || filepath.Ext(fi.Name()) != ".html" {
+			continue
+		}
+		name := strings.TrimSuffix(fi.Name(), ".html")
+
+		tmpl := &TemplateCreate{
+			Name:        name,
+			HtmlPageURL: baseURL.ResolveReference(&url.URL{Path: fi.Name()}).String(),
+		}
+		h := sha256.New()
+		if err := hashFile(h, filepath.Join(dir, fi.Name())); err != nil {
+			return nil, err
+		}
+		if err := hashFile(h, filepath.Join(dir, name+".zip")); err == nil {
+			tmpl.ZipFileURL = baseURL.ResolveReference(&url.URL{Path: name + ".zip"}).String()
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+		templates = append(templates, &LocalTemplate{TemplateCreate: tmpl, Hash: hex.EncodeToString(h.Sum(nil))})
+	}
+	return templates, nil
+}
+
+// hashFile writes the length and contents of the named file to h.
+func hashFile(h io.Writer, name string) error {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(h, "%d\n", len(data))
+	_, err = h.Write(data)
+	return err
+}
+
+// TemplatePlan describes the changes needed to make a client's templates match
+// a set of local templates.
+type TemplatePlan struct {
+	Create []*TemplateCreate
+	Update []TemplateUpdate
+	Delete []*Template
+}
+
+// TemplateUpdate is a template in a TemplatePlan whose content is replaced.
+type TemplateUpdate struct {
+	TemplateID string
+	*TemplateCreate
+}
+
+// PlanTemplateSync compares local templates with a client's existing (remote)
+// templates by name. Local templates without a remote counterpart are
+// created. Remote templates without a local counterpart are deleted only if
+// prune is set.
+//
+// The API does not expose the source of existing templates, so whether a
+// template changed is decided from synced, which maps the IDs of remote
+// templates to the Hash of the local template last uploaded to them (see
+// SyncedTemplates). Local templates with a remote counterpart are updated
+// unless synced records their current Hash for it. If synced is nil, all of
+// them are updated. Changes made to templates outside of the sync are not
+// detected.
+func PlanTemplateSync(local []*LocalTemplate, remote []*Template, synced map[string]string, prune bool) *TemplatePlan {
+	byName := make(map[string]*Template, len(remote))
+	for _, t := range remote {
+		if _, dup := byName[t.Name]; !dup {
+			byName[t.Name] = t
+		}
+	}
+
+	plan := &TemplatePlan{}
+	wanted := make(map[string]bool, len(local))
+	for _, l := range local {
+		wanted[l.Name] = true
+		if r, ok := byName[l.Name]; ok {
+			if l.Hash != "" && synced[r.TemplateID] == l.Hash {
+				continue
+			}
+			plan.Update = append(plan.Update, TemplateUpdate{TemplateID: r.TemplateID, TemplateCreate: l.TemplateCreate})
+		} else {
+			plan.Create = append(plan.Create, l.TemplateCreate)
+		}
+	}
+	if prune {
+		for _, r := range remote {
+			if !wanted[r.Name] || byName[r.Name] != r {
+				plan.Delete = append(plan.Delete, r)
+			}
+		}
+	}
+
+	sort.Slice(plan.Create, func(i, j int) bool { return plan.Create[i].Name < plan.Create[j].Name })
+	sort.Slice(plan.Update, func(i, j int) bool { return plan.Update[i].Name < plan.Update[j].Name })
+	sort.Slice(plan.Delete, func(i, j int) bool { return plan.Delete[i].Name < plan.Delete[j].Name })
+	return plan
+}
+
+// SyncedTemplates returns the synced map for PlanTemplateSync after local
+// templates have been applied to a client whose templates are now remote.
+func SyncedTemplates(local []*LocalTemplate, remote []*Template) map[string]string {
+	byName := make(map[string]*LocalTemplate, len(local))
+	for _, l := range local {
+		byName[l.Name] = l
+	}
+	synced := make(map[string]string)
+	for _, r := range remote {
+		if l, ok := byName[r.Name]; ok && l.Hash != "" {
+			synced[r.TemplateID] = l.Hash
+		}
+	}
+	return synced
+}
+
+// ReadSyncedTemplates reads a synced map written by WriteSyncedTemplates. It
+// returns nil if the file does not exist.
+func ReadSyncedTemplates(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var synced map[string]string
+	if err := json.Unmarshal(data, &synced); err != nil {
+		return nil, err
+	}
+	return synced, nil
+}
+
+// WriteSyncedTemplates atomically replaces the file at path with synced.
+func WriteSyncedTemplates(path string, synced map[string]string) error {
+	data, err := json.MarshalIndent(synced, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Empty reports whether the plan makes no changes.
+func (p *TemplatePlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// String formats the plan as a diff, with one line per template prefixed by
+// "+" (create), "~" (update) or "-" (delete).
+func (p *TemplatePlan) String() string {
+	var buf bytes.Buffer
+	for _, t := range p.Create {
+		fmt.Fprintf(&buf, "+ %s\n", t.Name)
+	}
+	for _, t := range p.Update {
+		fmt.Fprintf(&buf, "~ %s (%s)\n", t.Name, t.TemplateID)
+	}
+	for _, t := range p.Delete {
+		fmt.Fprintf(&buf, "- %s (%s)\n", t.Name, t.TemplateID)
+	}
+	return buf.String()
+}
+
+// ApplyTemplatePlan makes the changes described by plan to a client's
+// templates. It stops at the first error.
+func (c *APIClient) ApplyTemplatePlan(ctx context.Context, clientID string, plan *TemplatePlan) error {
+	for _, t := range plan.Create {
+		if _, err := c.CreateTemplate(ctx, clientID, t); err != nil {
+			return fmt.Errorf("creating template %q: %w", t.Name, err)
+		}
+	}
+	for _, t := range plan.Update {
+		if err := c.UpdateTemplate(ctx, t.TemplateID, t.TemplateCreate); err != nil {
+			return fmt.Errorf("updating template %q: %w", t.Name, err)
+		}
+	}
+	for _, t := range plan.Delete {
+		if err := c.DeleteTemplate(ctx, t.TemplateID); err != nil {
+			return fmt.Errorf("deleting template %q: %w", t.Name, err)
+		}
+	}
+	return nil
+}
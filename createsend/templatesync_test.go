@@ -0,0 +1,172 @@
+package createsend
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLocalTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "createsend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"newsletter.html", "newsletter.zip", "receipt.html", "README.md"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	base, _ := url.Parse("https://example.com/templates/")
+	templates, err := LocalTemplates(dir, base)
+	if err != nil {
+		t.Fatalf("LocalTemplates returned error: %v", err)
+	}
+
+	// The HTML files are both empty, but the newsletter also has images.
+	if len(templates) != 2 || templates[0].Hash == "" || templates[0].Hash == templates[1].Hash {
+		t.Fatalf("LocalTemplates returned %+v, want distinct hashes", templates)
+	}
+	hash := templates[1].Hash
+
+	var got []*TemplateCreate
+	for _, tmpl := range templates {
+		got = append(got, tmpl.TemplateCreate)
+	}
+	want := []*TemplateCreate{
+		{Name: "newsletter", HtmlPageURL: "https://example.com/templates/newsletter.html", ZipFileURL: "https://example.com/templates/newsletter.zip"},
+		{Name: "receipt", HtmlPageURL: "https://example.com/templates/receipt.html"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LocalTemplates returned %+v, want %+v", got, want)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "receipt.html"), []byte("<p>Receipt</p>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	templates, err = LocalTemplates(dir, base)
+	if err != nil {
+		t.Fatalf("LocalTemplates returned error: %v", err)
+	}
+	if templates[1].Hash == hash {
+		t.Error("LocalTemplates returned the same Hash after the template changed")
+	}
+}
+
+func TestPlanTemplateSync(t *testing.T) {
+	local := []*LocalTemplate{
+		{TemplateCreate: &TemplateCreate{Name: "receipt", HtmlPageURL: "https://example.com/receipt.html"}},
+		{TemplateCreate: &TemplateCreate{Name: "newsletter", HtmlPageURL: "https://example.com/newsletter.html"}},
+	}
+	remote := []*Template{
+		{TemplateID: "1", Name: "newsletter"},
+		{TemplateID: "2", Name: "old"},
+	}
+
+	plan := PlanTemplateSync(local, remote, nil, false)
+	want := &TemplatePlan{
+		Create: []*TemplateCreate{local[0].TemplateCreate},
+		Update: []TemplateUpdate{{TemplateID: "1", TemplateCreate: local[1].TemplateCreate}},
+	}
+	if !reflect.DeepEqual(plan, want) {
+		t.Errorf("PlanTemplateSync returned %+v, want %+v", plan, want)
+	}
+
+	plan = PlanTemplateSync(local, remote, nil, true)
+	want.Delete = []*Template{remote[1]}
+	if !reflect.DeepEqual(plan, want) {
+		t.Errorf("PlanTemplateSync with prune returned %+v, want %+v", plan, want)
+	}
+
+	wantDiff := "+ receipt\n~ newsletter (1)\n- old (2)\n"
+	if diff := plan.String(); diff != wantDiff {
+		t.Errorf("TemplatePlan.String() = %q, want %q", diff, wantDiff)
+	}
+}
+
+func TestPlanTemplateSync_unchanged(t *testing.T) {
+	local := []*LocalTemplate{
+		{TemplateCreate: &TemplateCreate{Name: "newsletter"}, Hash: "h1"},
+		{TemplateCreate: &TemplateCreate{Name: "receipt"}, Hash: "h2"},
+	}
+	remote := []*Template{
+		{TemplateID: "1", Name: "newsletter"},
+		{TemplateID: "2", Name: "receipt"},
+	}
+
+	// The newsletter was last synced with its current content, and the
+	// receipt with older content.
+	synced := map[string]string{"1": "h1", "2": "h0"}
+	plan := PlanTemplateSync(local, remote, synced, false)
+	want := &TemplatePlan{Update: []TemplateUpdate{{TemplateID: "2", TemplateCreate: local[1].TemplateCreate}}}
+	if !reflect.DeepEqual(plan, want) {
+		t.Errorf("PlanTemplateSync returned %+v, want %+v", plan, want)
+	}
+
+	synced = SyncedTemplates(local, remote)
+	if want := map[string]string{"1": "h1", "2": "h2"}; !reflect.DeepEqual(synced, want) {
+		t.Errorf("SyncedTemplates returned %v, want %v", synced, want)
+	}
+	if plan := PlanTemplateSync(local, remote, synced, false); !plan.Empty() {
+		t.Errorf("PlanTemplateSync after sync returned %+v, want an empty plan", plan)
+	}
+}
+
+func TestSyncedTemplatesFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "createsend")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "synced.json")
+	if synced, err := ReadSyncedTemplates(path); err != nil || synced != nil {
+		t.Errorf("ReadSyncedTemplates on missing file = %v, %v; want nil, nil", synced, err)
+	}
+
+	want := map[string]string{"1": "h1"}
+	if err := WriteSyncedTemplates(path, want); err != nil {
+		t.Fatalf("WriteSyncedTemplates returned error: %v", err)
+	}
+	synced, err := ReadSyncedTemplates(path)
+	if err != nil {
+		t.Fatalf("ReadSyncedTemplates returned error: %v", err)
+	}
+	if !reflect.DeepEqual(synced, want) {
+		t.Errorf("ReadSyncedTemplates returned %v, want %v", synced, want)
+	}
+}
+
+func TestApplyTemplatePlan(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == "POST" {
+			w.Write([]byte(`"3"`))
+		}
+	})
+
+	plan := &TemplatePlan{
+		Create: []*TemplateCreate{{Name: "receipt"}},
+		Update: []TemplateUpdate{{TemplateID: "1", TemplateCreate: &TemplateCreate{Name: "newsletter"}}},
+		Delete: []*Template{{TemplateID: "2", Name: "old"}},
+	}
+	if err := client.ApplyTemplatePlan(context.Background(), "12ab", plan); err != nil {
+		t.Errorf("ApplyTemplatePlan returned error: %v", err)
+	}
+
+	want := []string{"POST /templates/12ab.json", "PUT /templates/1.json", "DELETE /templates/2.json"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("ApplyTemplatePlan sent %v, want %v", calls, want)
+	}
+}
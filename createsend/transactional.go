@@ -0,0 +1,539 @@
+package createsend
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ConsentToTrack values indicate whether a transactional email's recipients
+// have consented to their opens and clicks being tracked.
+//
+// See https://www.campaignmonitor.com/api/transactional/ for more
+// information.
+const (
+	ConsentToTrackYes       = "Yes"
+	ConsentToTrackNo        = "No"
+	ConsentToTrackUnchanged = "Unchanged"
+)
+
+// SmartEmailListOptions represents the URL parameters that may be used to
+// filter the smart emails returned by SmartEmails. Status is "all" (the
+// default), "active" or "draft". ClientID is required when authenticating
+// with an account API key.
+//
+// See https://www.campaignmonitor.com/api/transactional/#smart_email_listing
+// for more information.
+type SmartEmailListOptions struct {
+	Status   string
+	ClientID string
+}
+
+// SmartEmail represents a smart (templated) transactional email.
+type SmartEmail struct {
+	ID        string `json:"ID"`
+	Name      string `json:"Name"`
+	CreatedAt string `json:"CreatedAt"`
+	Status    string `json:"Status"`
+}
+
+// SmartEmails lists the smart transactional emails of a client.
+//
+// See https://www.campaignmonitor.com/api/transactional/#smart_email_listing
+// for more information.
+func (c *APIClient) SmartEmails(ctx context.Context, opt *SmartEmailListOptions) ([]*SmartEmail, error) {
+	u := "transactional/smartEmail"
+
+	if opt != nil {
+		v := url.Values{}
+		if opt.Status != "" {
+			v.Set("status", opt.Status)
+		}
+		if opt.ClientID != "" {
+			v.Set("clientID", opt.ClientID)
+		}
+
+		q := v.Encode()
+		if q != "" {
+			u = fmt.Sprintf("%s?%s", u, q)
+		}
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []*SmartEmail
+	err = c.Do(ctx, req, &emails)
+	if err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+// SmartEmailDetails represents the details of a smart transactional email.
+// AddRecipientsToList holds the ID of the list its recipients are added to,
+// if any.
+//
+// See https://www.campaignmonitor.com/api/transactional/#smart_email_details
+// for more information.
+type SmartEmailDetails struct {
+	SmartEmailID        string               `json:"SmartEmailID"`
+	Name                string               `json:"Name"`
+	CreatedAt           string               `json:"CreatedAt"`
+	Status              string               `json:"Status"`
+	Properties          SmartEmailProperties `json:"Properties"`
+	AddRecipientsToList string               `json:"AddRecipientsToList"`
+}
+
+type SmartEmailProperties struct {
+	From           string            `json:"From"`
+	ReplyTo        string            `json:"ReplyTo"`
+	Subject        string            `json:"Subject"`
+	Content        SmartEmailContent `json:"Content"`
+	TextPreviewUrl string            `json:"TextPreviewUrl"`
+	HtmlPreviewUrl string            `json:"HtmlPreviewUrl"`
+}
+
+type SmartEmailContent struct {
+	Html           string   `json:"Html"`
+	Text           string   `json:"Text"`
+	EmailVariables []string `json:"EmailVariables"`
+	InlineCss      bool     `json:"InlineCss"`
+}
+
+// SmartEmailDetails returns the details of a smart transactional email.
+//
+// See https://www.campaignmonitor.com/api/transactional/#smart_email_details
+// for more information.
+func (c *APIClient) SmartEmailDetails(ctx context.Context, smartEmailID string) (*SmartEmailDetails, error) {
+	u := fmt.Sprintf("transactional/smartEmail/%s", smartEmailID)
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var details SmartEmailDetails
+	err = c.Do(ctx, req, &details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// Attachment represents a file attached to a transactional email. Content
+// holds the file's contents, base64 encoded.
+type Attachment struct {
+	Content string `json:"Content"`
+	Name    string `json:"Name"`
+	Type    string `json:"Type"`
+}
+
+// NewAttachment reads a file's contents from r and returns it as an
+// Attachment with the given file name and MIME type.
+func NewAttachment(name, contentType string, r io.Reader) (Attachment, error) {
+	var buf bytes.Buffer
+	enc := base64.NewEncoder(base64.StdEncoding, &buf)
+	if _, err := io.Copy(enc, r); err != nil {
+		return Attachment{}, err
+	}
+	if err := enc.Close(); err != nil {
+		return Attachment{}, err
+	}
+	return Attachment{Content: buf.String(), Name: name, Type: contentType}, nil
+}
+
+// SmartEmailSend represents the parameters needed to send a smart
+// transactional email. Recipients are formatted as "Name <email@example.com>"
+// or just "email@example.com". Data holds the values of the email's
+// variables.
+//
+// See https://www.campaignmonitor.com/api/transactional/#send_smart_email for
+// more information.
+type SmartEmailSend struct {
+	To                  []string               `json:"To"`
+	CC                  []string               `json:"CC,omitempty"`
+	BCC                 []string               `json:"BCC,omitempty"`
+	Attachments         []Attachment           `json:"Attachments,omitempty"`
+	Data                map[string]interface{} `json:"Data,omitempty"`
+	AddRecipientsToList bool                   `json:"AddRecipientsToList"`
+	ConsentToTrack      string                 `json:"ConsentToTrack"`
+}
+
+// SendResult represents the outcome of sending a transactional email to one
+// recipient.
+type SendResult struct {
+	MessageID string `json:"MessageID"`
+	Status    string `json:"Status"`
+	Recipient string `json:"Recipient"`
+}
+
+// SendSmartEmail sends a smart transactional email and returns the result for
+// each recipient.
+//
+// See https://www.campaignmonitor.com/api/transactional/#send_smart_email for
+// more information.
+func (c *APIClient) SendSmartEmail(ctx context.Context, smartEmailID string, send *SmartEmailSend) ([]*SendResult, error) {
+	u := fmt.Sprintf("transactional/smartEmail/%s/send", smartEmailID)
+
+	req, err := c.NewRequest("POST", u, send)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*SendResult
+	err = c.Do(ctx, req, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ClassicEmail represents a transactional email whose content is supplied
+// with each send, rather than defined by a smart email. Recipients are
+// formatted as for SmartEmailSend. Group is used to aggregate the statistics
+// of related emails, and AddRecipientsToListID, if set, is the ID of a list
+// to which the recipients are added.
+//
+// See https://www.campaignmonitor.com/api/transactional/#send_classic_email
+// for more information.
+type ClassicEmail struct {
+	Subject               string       `json:"Subject"`
+	From                  string       `json:"From"`
+	ReplyTo               string       `json:"ReplyTo,omitempty"`
+	To                    []string     `json:"To"`
+	CC                    []string     `json:"CC,omitempty"`
+	BCC                   []string     `json:"BCC,omitempty"`
+	Html                  string       `json:"Html"`
+	Text                  string       `json:"Text,omitempty"`
+	Attachments           []Attachment `json:"Attachments,omitempty"`
+	TrackOpens            bool         `json:"TrackOpens"`
+	TrackClicks           bool         `json:"TrackClicks"`
+	InlineCSS             bool         `json:"InlineCSS"`
+	Group                 string       `json:"Group,omitempty"`
+	AddRecipientsToListID string       `json:"AddRecipientsToListID,omitempty"`
+	ConsentToTrack        string       `json:"ConsentToTrack"`
+}
+
+// SendClassicEmail sends a classic transactional email and returns the result
+// for each recipient. The clientID is required when authenticating with an
+// account API key, and may otherwise be empty.
+//
+// See https://www.campaignmonitor.com/api/transactional/#send_classic_email
+// for more information.
+func (c *APIClient) SendClassicEmail(ctx context.Context, clientID string, email *ClassicEmail) ([]*SendResult, error) {
+	u := "transactional/classicEmail/send"
+	if clientID != "" {
+		u = fmt.Sprintf("%s?clientID=%s", u, url.QueryEscape(clientID))
+	}
+
+	req, err := c.NewRequest("POST", u, email)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*SendResult
+	err = c.Do(ctx, req, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// MessageTimelineOptions represents the URL parameters that may be used to
+// filter and page through the transactional message timeline. Status is
+// "all" (the default), "delivered", "bounced", "spam" and so on. Count is the
+// number of messages to return, newest first, up to 200. SentBeforeID and
+// SentAfterID are message IDs used as cursors to page through the timeline.
+// ClientID is required when authenticating with an account API key.
+//
+// See https://www.campaignmonitor.com/api/transactional/#message_timeline for
+// more information.
+type MessageTimelineOptions struct {
+	Status       string
+	Count        int
+	SentBeforeID string
+	SentAfterID  string
+	SmartEmailID string
+	Group        string
+	ClientID     string
+}
+
+// Message represents a transactional message in the message timeline.
+type Message struct {
+	MessageID    string `json:"MessageID"`
+	Status       string `json:"Status"`
+	SentAt       string `json:"SentAt"`
+	Recipient    string `json:"Recipient"`
+	From         string `json:"From"`
+	Subject      string `json:"Subject"`
+	SmartEmailID string `json:"SmartEmailID,omitempty"`
+	Group        string `json:"Group,omitempty"`
+	TotalOpens   int    `json:"TotalOpens"`
+	TotalClicks  int    `json:"TotalClicks"`
+	CanBeResent  bool   `json:"CanBeResent"`
+}
+
+// MessageTimeline lists transactional messages, newest first.
+//
+// See https://www.campaignmonitor.com/api/transactional/#message_timeline for
+// more information.
+func (c *APIClient) MessageTimeline(ctx context.Context, opt *MessageTimelineOptions) ([]*Message, error) {
+	u := "transactional/messages"
+
+	if opt != nil {
+		v := url.Values{}
+		if opt.Status != "" {
+			v.Set("status", opt.Status)
+		}
+		if opt.Count > 0 {
+			v.Set("count", strconv.Itoa(opt.Count))
+		}
+		if opt.SentBeforeID != "" {
+			v.Set("sentBeforeID", opt.SentBeforeID)
+		}
+		if opt.SentAfterID != "" {
+			v.Set("sentAfterID", opt.SentAfterID)
+		}
+		if opt.SmartEmailID != "" {
+			v.Set("smartEmailID", opt.SmartEmailID)
+		}
+		if opt.Group != "" {
+			v.Set("group", opt.Group)
+		}
+		if opt.ClientID != "" {
+			v.Set("clientID", opt.ClientID)
+		}
+
+		q := v.Encode()
+		if q != "" {
+			u = fmt.Sprintf("%s?%s", u, q)
+		}
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*Message
+	err = c.Do(ctx, req, &messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// MessageDetails represents the complete details of a transactional message.
+// Opens and Clicks are only populated when requested.
+//
+// See https://www.campaignmonitor.com/api/transactional/#message_details for
+// more information.
+type MessageDetails struct {
+	MessageID    string         `json:"MessageID"`
+	Status       string         `json:"Status"`
+	SentAt       string         `json:"SentAt"`
+	SmartEmailID string         `json:"SmartEmailID,omitempty"`
+	Group        string         `json:"Group,omitempty"`
+	CanBeResent  bool           `json:"CanBeResent"`
+	Recipient    string         `json:"Recipient"`
+	Message      MessageContent `json:"Message"`
+	TotalOpens   int            `json:"TotalOpens"`
+	TotalClicks  int            `json:"TotalClicks"`
+	Opens        []MessageOpen  `json:"Opens,omitempty"`
+	Clicks       []MessageClick `json:"Clicks,omitempty"`
+}
+
+type MessageContent struct {
+	From        string                 `json:"From"`
+	Subject     string                 `json:"Subject"`
+	To          []string               `json:"To"`
+	CC          []string               `json:"CC"`
+	BCC         []string               `json:"BCC"`
+	ReplyTo     string                 `json:"ReplyTo"`
+	Attachments []Attachment           `json:"Attachments"`
+	Body        MessageBody            `json:"Body"`
+	Data        map[string]interface{} `json:"Data"`
+}
+
+type MessageBody struct {
+	Html string `json:"Html"`
+	Text string `json:"Text"`
+}
+
+type MessageOpen struct {
+	EmailAddress string      `json:"EmailAddress"`
+	Date         string      `json:"Date"`
+	IPAddress    string      `json:"IPAddress"`
+	Geolocation  Geolocation `json:"Geolocation"`
+	MailClient   MailClient  `json:"MailClient"`
+}
+
+type MessageClick struct {
+	EmailAddress string      `json:"EmailAddress"`
+	Date         string      `json:"Date"`
+	URL          string      `json:"URL"`
+	IPAddress    string      `json:"IPAddress"`
+	Geolocation  Geolocation `json:"Geolocation"`
+	MailClient   MailClient  `json:"MailClient"`
+}
+
+type Geolocation struct {
+	Latitude    float64 `json:"Latitude"`
+	Longitude   float64 `json:"Longitude"`
+	City        string  `json:"City"`
+	Region      string  `json:"Region"`
+	CountryCode string  `json:"CountryCode"`
+	CountryName string  `json:"CountryName"`
+}
+
+type MailClient struct {
+	Name    string `json:"Name"`
+	Version string `json:"Version"`
+}
+
+// MessageDetails returns the details of a transactional message. If
+// statistics is set, its opens and clicks are included.
+//
+// See https://www.campaignmonitor.com/api/transactional/#message_details for
+// more information.
+func (c *APIClient) MessageDetails(ctx context.Context, messageID string, statistics bool) (*MessageDetails, error) {
+	u := fmt.Sprintf("transactional/messages/%s", messageID)
+	if statistics {
+		u += "?statistics=true"
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var details MessageDetails
+	err = c.Do(ctx, req, &details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// ResendMessage sends a transactional message again, to the same recipients,
+// and returns the result for each recipient.
+//
+// See https://www.campaignmonitor.com/api/transactional/#message_resend for
+// more information.
+func (c *APIClient) ResendMessage(ctx context.Context, messageID string) ([]*SendResult, error) {
+	u := fmt.Sprintf("transactional/messages/%s/resend", messageID)
+
+	req, err := c.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*SendResult
+	err = c.Do(ctx, req, &results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// MessageStatisticsOptions represents the URL parameters that may be used to
+// select the transactional messages aggregated by MessageStatistics. From and
+// To are dates (only the day is used); TimeZone is "client" (the default) or
+// "utc". ClientID is required when authenticating with an account API key.
+//
+// See https://www.campaignmonitor.com/api/transactional/#statistics for more
+// information.
+type MessageStatisticsOptions struct {
+	From         time.Time
+	To           time.Time
+	TimeZone     string
+	Group        string
+	SmartEmailID string
+	ClientID     string
+}
+
+// MessageStatistics represents aggregated delivery statistics of
+// transactional messages.
+type MessageStatistics struct {
+	Query     MessageStatisticsQuery `json:"Query"`
+	Sent      int                    `json:"Sent"`
+	Bounces   int                    `json:"Bounces"`
+	Delivered int                    `json:"Delivered"`
+	Opened    int                    `json:"Opened"`
+	Clicked   int                    `json:"Clicked"`
+}
+
+// MessageStatisticsQuery echoes the parameters the statistics were computed
+// for.
+type MessageStatisticsQuery struct {
+	From         string `json:"From"`
+	To           string `json:"To"`
+	TimeZone     string `json:"TimeZone"`
+	Group        string `json:"Group,omitempty"`
+	SmartEmailID string `json:"SmartEmailID,omitempty"`
+	ClientID     string `json:"ClientID,omitempty"`
+}
+
+// MessageStatistics returns delivery statistics of transactional messages,
+// aggregated over a date range.
+//
+// See https://www.campaignmonitor.com/api/transactional/#statistics for more
+// information.
+func (c *APIClient) MessageStatistics(ctx context.Context, opt *MessageStatisticsOptions) (*MessageStatistics, error) {
+	u := "transactional/statistics"
+
+	if opt != nil {
+		v := url.Values{}
+		if !opt.From.IsZero() {
+			v.Set("from", opt.From.Format("2006-01-02"))
+		}
+		if !opt.To.IsZero() {
+			v.Set("to", opt.To.Format("2006-01-02"))
+		}
+		if opt.TimeZone != "" {
+			v.Set("timezone", opt.TimeZone)
+		}
+		if opt.Group != "" {
+			v.Set("group", opt.Group)
+		}
+		if opt.SmartEmailID != "" {
+			v.Set("smartEmailID", opt.SmartEmailID)
+		}
+		if opt.ClientID != "" {
+			v.Set("clientID", opt.ClientID)
+		}
+
+		q := v.Encode()
+		if q != "" {
+			u = fmt.Sprintf("%s?%s", u, q)
+		}
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats MessageStatistics
+	err = c.Do(ctx, req, &stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,367 @@
+package createsend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSmartEmails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/smartEmail", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "clientID=12ab&status=active")
+		fmt.Fprint(w, `[{"ID": "1e654df2-f484-11e4-970c-6c4008bc7468", "Name": "Welcome email", "CreatedAt": "2015-05-01T12:00:00+10:00", "Status": "Active"}]`)
+	})
+
+	emails, err := client.SmartEmails(context.Background(), &SmartEmailListOptions{Status: "active", ClientID: "12ab"})
+	if err != nil {
+		t.Errorf("SmartEmails returned error: %v", err)
+	}
+
+	want := []*SmartEmail{{ID: "1e654df2-f484-11e4-970c-6c4008bc7468", Name: "Welcome email", CreatedAt: "2015-05-01T12:00:00+10:00", Status: "Active"}}
+	if !reflect.DeepEqual(emails, want) {
+		t.Errorf("SmartEmails returned %+v, want %+v", emails, want)
+	}
+}
+
+func TestSmartEmailDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/smartEmail/1e65", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"SmartEmailID": "1e65",
+			"CreatedAt": "2015-05-01T12:00:00+10:00",
+			"Status": "Active",
+			"Name": "Welcome email",
+			"Properties": {
+				"From": "Acme <hello@example.com>",
+				"ReplyTo": "support@example.com",
+				"Subject": "Welcome, {{firstname}}",
+				"Content": {
+					"Html": "<p>Hi {{firstname}}</p>",
+					"Text": "Hi {{firstname}}",
+					"EmailVariables": ["firstname"],
+					"InlineCss": true
+				},
+				"TextPreviewUrl": "https://example.com/text",
+				"HtmlPreviewUrl": "https://example.com/html"
+			},
+			"AddRecipientsToList": "34cd"
+		}`)
+	})
+
+	details, err := client.SmartEmailDetails(context.Background(), "1e65")
+	if err != nil {
+		t.Errorf("SmartEmailDetails returned error: %v", err)
+	}
+
+	want := &SmartEmailDetails{
+		SmartEmailID: "1e65",
+		Name:         "Welcome email",
+		CreatedAt:    "2015-05-01T12:00:00+10:00",
+		Status:       "Active",
+		Properties: SmartEmailProperties{
+			From:    "Acme <hello@example.com>",
+			ReplyTo: "support@example.com",
+			Subject: "Welcome, {{firstname}}",
+			Content: SmartEmailContent{
+				Html:           "<p>Hi {{firstname}}</p>",
+				Text:           "Hi {{firstname}}",
+				EmailVariables: []string{"firstname"},
+				InlineCss:      true,
+			},
+			TextPreviewUrl: "https://example.com/text",
+			HtmlPreviewUrl: "https://example.com/html",
+		},
+		AddRecipientsToList: "34cd",
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("SmartEmailDetails returned %+v, want %+v", details, want)
+	}
+}
+
+func TestSendSmartEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/smartEmail/1e65/send", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"To":["Alice \u003calice@example.com\u003e"],"BCC":["audit@example.com"],"Attachments":[{"Content":"aGk=","Name":"hi.txt","Type":"text/plain"}],"Data":{"firstname":"Alice"},"AddRecipientsToList":false,"ConsentToTrack":"Yes"}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `[{"Status": "Accepted", "MessageID": "0cfe150d-d507-11e4-84a7-c31e5b59881d", "Recipient": "alice@example.com"}]`)
+	})
+
+	results, err := client.SendSmartEmail(context.Background(), "1e65", &SmartEmailSend{
+		To:             []string{"Alice <alice@example.com>"},
+		BCC:            []string{"audit@example.com"},
+		Attachments:    []Attachment{{Content: "aGk=", Name: "hi.txt", Type: "text/plain"}},
+		Data:           map[string]interface{}{"firstname": "Alice"},
+		ConsentToTrack: ConsentToTrackYes,
+	})
+	if err != nil {
+		t.Errorf("SendSmartEmail returned error: %v", err)
+	}
+
+	want := []*SendResult{{Status: "Accepted", MessageID: "0cfe150d-d507-11e4-84a7-c31e5b59881d", Recipient: "alice@example.com"}}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("SendSmartEmail returned %+v, want %+v", results, want)
+	}
+}
+
+func TestNewAttachment(t *testing.T) {
+	a, err := NewAttachment("hi.txt", "text/plain", strings.NewReader("hi"))
+	if err != nil {
+		t.Errorf("NewAttachment returned error: %v", err)
+	}
+
+	want := Attachment{Content: "aGk=", Name: "hi.txt", Type: "text/plain"}
+	if a != want {
+		t.Errorf("NewAttachment returned %+v, want %+v", a, want)
+	}
+}
+
+func TestSendClassicEmail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/classicEmail/send", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testQuerystring(t, r, "clientID=12ab")
+		testBody(t, r, `{"Subject":"Disk space low","From":"ops@example.com","To":["alice@example.com"],"Html":"Disk is 95% full.","TrackOpens":true,"TrackClicks":false,"InlineCSS":false,"Group":"Alerts","ConsentToTrack":"No"}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `[{"Status": "Accepted", "MessageID": "ddc697c7-0788-4df3-a71a-a7cb935f00bd", "Recipient": "alice@example.com"}]`)
+	})
+
+	results, err := client.SendClassicEmail(context.Background(), "12ab", &ClassicEmail{
+		Subject:        "Disk space low",
+		From:           "ops@example.com",
+		To:             []string{"alice@example.com"},
+		Html:           "Disk is 95% full.",
+		TrackOpens:     true,
+		Group:          "Alerts",
+		ConsentToTrack: ConsentToTrackNo,
+	})
+	if err != nil {
+		t.Errorf("SendClassicEmail returned error: %v", err)
+	}
+
+	want := []*SendResult{{Status: "Accepted", MessageID: "ddc697c7-0788-4df3-a71a-a7cb935f00bd", Recipient: "alice@example.com"}}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("SendClassicEmail returned %+v, want %+v", results, want)
+	}
+}
+
+func TestMessageTimeline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "count=2&group=Receipts&sentBeforeID=ddc697c7&status=delivered")
+		fmt.Fprint(w, `[
+			{
+				"MessageID": "ddc697c7-0788-4df3-a71a-a7cb935f00bd",
+				"Status": "Delivered",
+				"SentAt": "2014-01-15T16:09:19-05:00",
+				"Recipient": "alice@example.com",
+				"From": "receipts@example.com",
+				"Subject": "Your receipt",
+				"TotalOpens": 1,
+				"TotalClicks": 0,
+				"CanBeResent": true,
+				"Group": "Receipts"
+			}
+		]`)
+	})
+
+	messages, err := client.MessageTimeline(context.Background(), &MessageTimelineOptions{
+		Status:       "delivered",
+		Count:        2,
+		SentBeforeID: "ddc697c7",
+		Group:        "Receipts",
+	})
+	if err != nil {
+		t.Errorf("MessageTimeline returned error: %v", err)
+	}
+
+	want := []*Message{{
+		MessageID:   "ddc697c7-0788-4df3-a71a-a7cb935f00bd",
+		Status:      "Delivered",
+		SentAt:      "2014-01-15T16:09:19-05:00",
+		Recipient:   "alice@example.com",
+		From:        "receipts@example.com",
+		Subject:     "Your receipt",
+		Group:       "Receipts",
+		TotalOpens:  1,
+		CanBeResent: true,
+	}}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("MessageTimeline returned %+v, want %+v", messages, want)
+	}
+}
+
+func TestMessageDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages/ddc697c7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "statistics=true")
+		fmt.Fprint(w, `{
+			"MessageID": "ddc697c7",
+			"Status": "Delivered",
+			"SentAt": "2014-01-15T16:09:19-05:00",
+			"SmartEmailID": "1e65",
+			"CanBeResent": true,
+			"Recipient": "alice@example.com",
+			"Message": {
+				"From": "receipts@example.com",
+				"Subject": "Your receipt",
+				"To": ["alice@example.com"],
+				"CC": [],
+				"BCC": [],
+				"ReplyTo": "support@example.com",
+				"Attachments": [],
+				"Body": {"Html": "<p>Thanks</p>", "Text": "Thanks"},
+				"Data": {"total": "$10"}
+			},
+			"TotalOpens": 1,
+			"TotalClicks": 1,
+			"Opens": [
+				{
+					"EmailAddress": "alice@example.com",
+					"Date": "2014-01-15T16:10:00-05:00",
+					"IPAddress": "192.168.0.1",
+					"Geolocation": {"Latitude": -33.8683, "Longitude": 151.2086, "City": "Sydney", "Region": "New South Wales", "CountryCode": "AU", "CountryName": "Australia"},
+					"MailClient": {"Name": "Apple Mail", "Version": "Apple Mail 6"}
+				}
+			],
+			"Clicks": [
+				{
+					"EmailAddress": "alice@example.com",
+					"Date": "2014-01-15T16:11:00-05:00",
+					"URL": "http://example.com/order",
+					"IPAddress": "192.168.0.1",
+					"Geolocation": {"City": "Sydney", "CountryCode": "AU"},
+					"MailClient": {"Name": "Apple Mail", "Version": "Apple Mail 6"}
+				}
+			]
+		}`)
+	})
+
+	details, err := client.MessageDetails(context.Background(), "ddc697c7", true)
+	if err != nil {
+		t.Errorf("MessageDetails returned error: %v", err)
+	}
+
+	mailClient := MailClient{Name: "Apple Mail", Version: "Apple Mail 6"}
+	want := &MessageDetails{
+		MessageID:    "ddc697c7",
+		Status:       "Delivered",
+		SentAt:       "2014-01-15T16:09:19-05:00",
+		SmartEmailID: "1e65",
+		CanBeResent:  true,
+		Recipient:    "alice@example.com",
+		Message: MessageContent{
+			From:        "receipts@example.com",
+			Subject:     "Your receipt",
+			To:          []string{"alice@example.com"},
+			CC:          []string{},
+			BCC:         []string{},
+			ReplyTo:     "support@example.com",
+			Attachments: []Attachment{},
+			Body:        MessageBody{Html: "<p>Thanks</p>", Text: "Thanks"},
+			Data:        map[string]interface{}{"total": "$10"},
+		},
+		TotalOpens:  1,
+		TotalClicks: 1,
+		Opens: []MessageOpen{{
+			EmailAddress: "alice@example.com",
+			Date:         "2014-01-15T16:10:00-05:00",
+			IPAddress:    "192.168.0.1",
+			Geolocation:  Geolocation{Latitude: -33.8683, Longitude: 151.2086, City: "Sydney", Region: "New South Wales", CountryCode: "AU", CountryName: "Australia"},
+			MailClient:   mailClient,
+		}},
+		Clicks: []MessageClick{{
+			EmailAddress: "alice@example.com",
+			Date:         "2014-01-15T16:11:00-05:00",
+			URL:          "http://example.com/order",
+			IPAddress:    "192.168.0.1",
+			Geolocation:  Geolocation{City: "Sydney", CountryCode: "AU"},
+			MailClient:   mailClient,
+		}},
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Errorf("MessageDetails returned %+v, want %+v", details, want)
+	}
+}
+
+func TestResendMessage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/messages/ddc697c7/resend", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `[{"Status": "Accepted", "MessageID": "f0e4b7a4", "Recipient": "alice@example.com"}]`)
+	})
+
+	results, err := client.ResendMessage(context.Background(), "ddc697c7")
+	if err != nil {
+		t.Errorf("ResendMessage returned error: %v", err)
+	}
+
+	want := []*SendResult{{Status: "Accepted", MessageID: "f0e4b7a4", Recipient: "alice@example.com"}}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("ResendMessage returned %+v, want %+v", results, want)
+	}
+}
+
+func TestMessageStatistics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/transactional/statistics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQuerystring(t, r, "from=2014-01-01&group=Receipts&timezone=utc&to=2014-01-31")
+		fmt.Fprint(w, `{
+			"Query": {"From": "2014-01-01", "To": "2014-01-31", "TimeZone": "UTC", "Group": "Receipts"},
+			"Sent": 1000,
+			"Bounces": 10,
+			"Delivered": 990,
+			"Opened": 500,
+			"Clicked": 100
+		}`)
+	})
+
+	stats, err := client.MessageStatistics(context.Background(), &MessageStatisticsOptions{
+		From:     time.Date(2014, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2014, time.January, 31, 0, 0, 0, 0, time.UTC),
+		TimeZone: "utc",
+		Group:    "Receipts",
+	})
+	if err != nil {
+		t.Errorf("MessageStatistics returned error: %v", err)
+	}
+
+	want := &MessageStatistics{
+		Query:     MessageStatisticsQuery{From: "2014-01-01", To: "2014-01-31", TimeZone: "UTC", Group: "Receipts"},
+		Sent:      1000,
+		Bounces:   10,
+		Delivered: 990,
+		Opened:    500,
+		Clicked:   100,
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("MessageStatistics returned %+v, want %+v", stats, want)
+	}
+}